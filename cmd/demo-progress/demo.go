@@ -11,6 +11,7 @@ import (
 var (
 	autoStop    = flag.Bool("auto-stop", false, "Auto-stop rendering?")
 	numTrackers = flag.Int("num-trackers", 13, "Number of Trackers")
+	showSpeed   = flag.Bool("show-speed", false, "Show the speed of each Tracker?")
 )
 
 func trackSomething(pw progress.Writer, idx int64) {
@@ -67,6 +68,7 @@ func main() {
 	pw.SetTrackerLength(25)
 	pw.ShowETA(true)
 	pw.ShowOverallTracker(true)
+	pw.ShowSpeed(*showSpeed)
 	pw.ShowTime(true)
 	pw.ShowTracker(true)
 	pw.ShowValue(true)
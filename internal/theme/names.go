@@ -0,0 +1,101 @@
+package theme
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/text"
+)
+
+// Names of the enumerations (in order) as used in the themes.
+var (
+	alignNames  = []string{"default", "left", "center", "justify", "right"}
+	formatNames = []string{"default", "lower", "title", "upper"}
+	valignNames = []string{"default", "top", "middle", "bottom"}
+)
+
+// align is a text.Align that gets encoded using its name; ex.: "center".
+type align text.Align
+
+func (a align) MarshalText() ([]byte, error) {
+	return marshalName("align", int(a), alignNames)
+}
+
+func (a *align) UnmarshalText(text []byte) error {
+	value, err := unmarshalName("align", text, alignNames)
+	if err == nil {
+		*a = align(value)
+	}
+	return err
+}
+
+// color is a text.Color that gets encoded using its name (see
+// text.Color.Name); ex.: "fg-hi-red".
+type color text.Color
+
+func (c color) MarshalText() ([]byte, error) {
+	name := text.Color(c).Name()
+	if name == "" {
+		return nil, fmt.Errorf("invalid color %d", int(c))
+	}
+	return []byte(name), nil
+}
+
+func (c *color) UnmarshalText(data []byte) error {
+	value, err := text.ColorByName(string(data))
+	if err == nil {
+		*c = color(value)
+	}
+	return err
+}
+
+// format is a text.Format that gets encoded using its name; ex.: "upper".
+type format text.Format
+
+func (f format) MarshalText() ([]byte, error) {
+	return marshalName("format", int(f), formatNames)
+}
+
+func (f *format) UnmarshalText(text []byte) error {
+	value, err := unmarshalName("format", text, formatNames)
+	if err == nil {
+		*f = format(value)
+	}
+	return err
+}
+
+// valign is a text.VAlign that gets encoded using its name; ex.: "middle".
+type valign text.VAlign
+
+func (va valign) MarshalText() ([]byte, error) {
+	return marshalName("vertical align", int(va), valignNames)
+}
+
+func (va *valign) UnmarshalText(text []byte) error {
+	value, err := unmarshalName("vertical align", text, valignNames)
+	if err == nil {
+		*va = valign(value)
+	}
+	return err
+}
+
+// marshalName returns the name of the enumeration value (of the given kind)
+// from the list of names indexed by value.
+func marshalName(kind string, value int, names []string) ([]byte, error) {
+	if value < 0 || value >= len(names) {
+		return nil, fmt.Errorf("invalid %s %d", kind, value)
+	}
+	return []byte(names[value]), nil
+}
+
+// unmarshalName returns the enumeration value (of the given kind) for the name
+// from the list of names indexed by value.
+func unmarshalName(kind string, text []byte, names []string) (int, error) {
+	name := strings.ToLower(strings.TrimSpace(string(text)))
+	for value, valueName := range names {
+		if name == valueName {
+			return value, nil
+		}
+	}
+	return 0, fmt.Errorf("invalid %s %#v", kind, string(text))
+}
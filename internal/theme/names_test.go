@@ -0,0 +1,108 @@
+package theme
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAlign(t *testing.T) {
+	for value, expected := range map[text.Align]string{
+		text.AlignDefault: "default",
+		text.AlignLeft:    "left",
+		text.AlignCenter:  "center",
+		text.AlignJustify: "justify",
+		text.AlignRight:   "right",
+	} {
+		name, err := align(value).MarshalText()
+		assert.Nil(t, err)
+		assert.Equal(t, expected, string(name))
+
+		var alignUnmarshaled align
+		assert.Nil(t, alignUnmarshaled.UnmarshalText([]byte(" "+strings.ToUpper(expected)+" ")))
+		assert.Equal(t, align(value), alignUnmarshaled)
+	}
+
+	_, err := align(-1).MarshalText()
+	assert.EqualError(t, err, "invalid align -1")
+	_, err = align(5).MarshalText()
+	assert.EqualError(t, err, "invalid align 5")
+
+	a := align(text.AlignRight)
+	assert.EqualError(t, a.UnmarshalText([]byte("middle")), `invalid align "middle"`)
+	assert.Equal(t, align(text.AlignRight), a)
+}
+
+func TestColor(t *testing.T) {
+	for value, expected := range map[text.Color]string{
+		text.Reset:              "reset",
+		text.FgHiRed:            "fg-hi-red",
+		text.Bg256(0):           "bg-256-0",
+		text.FgRGB(255, 136, 0): "fg-#ff8800",
+	} {
+		name, err := color(value).MarshalText()
+		assert.Nil(t, err)
+		assert.Equal(t, expected, string(name))
+
+		var colorUnmarshaled color
+		assert.Nil(t, colorUnmarshaled.UnmarshalText(name))
+		assert.Equal(t, color(value), colorUnmarshaled)
+	}
+
+	_, err := color(12345).MarshalText()
+	assert.EqualError(t, err, "invalid color 12345")
+
+	c := color(text.FgRed)
+	assert.EqualError(t, c.UnmarshalText([]byte("red")), `invalid color "red"`)
+	assert.Equal(t, color(text.FgRed), c)
+}
+
+func TestFormat(t *testing.T) {
+	for value, expected := range map[text.Format]string{
+		text.FormatDefault: "default",
+		text.FormatLower:   "lower",
+		text.FormatTitle:   "title",
+		text.FormatUpper:   "upper",
+	} {
+		name, err := format(value).MarshalText()
+		assert.Nil(t, err)
+		assert.Equal(t, expected, string(name))
+
+		var formatUnmarshaled format
+		assert.Nil(t, formatUnmarshaled.UnmarshalText(name))
+		assert.Equal(t, format(value), formatUnmarshaled)
+	}
+
+	_, err := format(4).MarshalText()
+	assert.EqualError(t, err, "invalid format 4")
+
+	f := format(text.FormatUpper)
+	assert.EqualError(t, f.UnmarshalText([]byte("UPPERCASE")), `invalid format "UPPERCASE"`)
+	assert.Equal(t, format(text.FormatUpper), f)
+}
+
+func TestVAlign(t *testing.T) {
+	for value, expected := range map[text.VAlign]string{
+		text.VAlignDefault: "default",
+		text.VAlignTop:     "top",
+		text.VAlignMiddle:  "middle",
+		text.VAlignBottom:  "bottom",
+	} {
+		name, err := valign(value).MarshalText()
+		assert.Nil(t, err)
+		assert.Equal(t, expected, string(name))
+
+		var vAlignUnmarshaled valign
+		assert.Nil(t, vAlignUnmarshaled.UnmarshalText(name))
+		assert.Equal(t, valign(value), vAlignUnmarshaled)
+	}
+
+	_, err := valign(4).MarshalText()
+	assert.EqualError(t, err, "invalid vertical align 4")
+
+	va := valign(text.VAlignBottom)
+	assert.EqualError(t, va.UnmarshalText([]byte("center")), `invalid vertical align "center"`)
+	assert.Equal(t, valign(text.VAlignBottom), va)
+}
@@ -0,0 +1,84 @@
+package theme
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Registry contains Styles (of any one type) that can be looked up by their
+// names. The lookup is case-insensitive, and the "Style" prefix and any
+// dashes/underscores/spaces in the names are optional; for ex.,
+// "StyleColoredBright", "coloredbright" and "colored-bright" are all the same.
+type Registry struct {
+	mutex  sync.RWMutex
+	styles map[string]registryEntry
+}
+
+type registryEntry struct {
+	name  string
+	style interface{}
+}
+
+// NewRegistry returns a Registry with the given Styles, which are registered
+// using the names returned by the function.
+func NewRegistry(nameOf func(style interface{}) string, styles ...interface{}) *Registry {
+	registry := &Registry{styles: make(map[string]registryEntry, len(styles))}
+	for _, style := range styles {
+		registry.styles[normalizeName(nameOf(style))] = registryEntry{name: nameOf(style), style: style}
+	}
+	return registry
+}
+
+// Get returns the Style with the given name.
+func (r *Registry) Get(name string) (interface{}, bool) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	entry, ok := r.styles[normalizeName(name)]
+	return entry.style, ok
+}
+
+// Names returns the names of all the Styles in sorted order.
+func (r *Registry) Names() []string {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	names := make([]string, 0, len(r.styles))
+	for _, entry := range r.styles {
+		names = append(names, entry.name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Register adds the Style to the Registry with the given name. A Style
+// registered with the name of an existing one replaces it.
+func (r *Registry) Register(name string, style interface{}) error {
+	if name == "" {
+		return fmt.Errorf("cannot register a style without a name")
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.styles[normalizeName(name)] = registryEntry{name: name, style: style}
+	return nil
+}
+
+// Unregister removes the Style with the given name from the Registry.
+func (r *Registry) Unregister(name string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	delete(r.styles, normalizeName(name))
+}
+
+// normalizeName returns the name in lower-case stripped of the "Style" prefix
+// and of any dashes/underscores/spaces.
+func normalizeName(name string) string {
+	name = strings.ToLower(strings.TrimSpace(name))
+	name = strings.NewReplacer("-", "", "_", "", " ", "").Replace(name)
+	if name != "style" {
+		name = strings.TrimPrefix(name, "style")
+	}
+	return name
+}
@@ -0,0 +1,63 @@
+package theme
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegistry(t *testing.T) {
+	nameOf := func(style interface{}) string {
+		return style.(testStyle).Name
+	}
+	registry := NewRegistry(nameOf, testStyle{Name: "StyleDefault"}, testStyle{Name: "StyleColoredBright"})
+	assert.Equal(t, []string{"StyleColoredBright", "StyleDefault"}, registry.Names())
+
+	for _, name := range []string{"StyleColoredBright", "stylecoloredbright", "ColoredBright", "colored-bright", " colored_bright ", "Colored Bright"} {
+		style, ok := registry.Get(name)
+		assert.True(t, ok, name)
+		assert.Equal(t, testStyle{Name: "StyleColoredBright"}, style, name)
+	}
+	_, ok := registry.Get("foo")
+	assert.False(t, ok)
+	_, ok = registry.Get("style")
+	assert.False(t, ok)
+
+	assert.EqualError(t, registry.Register("", testStyle{}), "cannot register a style without a name")
+	assert.Nil(t, registry.Register("MyStyle", testStyle{Name: "MyStyle"}))
+	assert.Nil(t, registry.Register("my-style", testStyle{Name: "my-style", Format: 1}))
+	style, ok := registry.Get("MyStyle")
+	assert.True(t, ok)
+	assert.Equal(t, testStyle{Name: "my-style", Format: 1}, style)
+	assert.Equal(t, []string{"StyleColoredBright", "StyleDefault", "my-style"}, registry.Names())
+
+	registry.Unregister("MYSTYLE")
+	_, ok = registry.Get("my-style")
+	assert.False(t, ok)
+	assert.Equal(t, []string{"StyleColoredBright", "StyleDefault"}, registry.Names())
+}
+
+func TestRegistry_Concurrency(t *testing.T) {
+	registry := NewRegistry(func(style interface{}) string { return style.(string) }, "StyleDefault")
+
+	done := make(chan bool)
+	for idx := 0; idx < 10; idx++ {
+		go func() {
+			_ = registry.Register("StyleTest", "StyleTest")
+			_, _ = registry.Get("test")
+			_ = registry.Names()
+			registry.Unregister("test")
+			done <- true
+		}()
+	}
+	for idx := 0; idx < 10; idx++ {
+		<-done
+	}
+	assert.Equal(t, []string{"StyleDefault"}, registry.Names())
+}
+
+func Test_normalizeName(t *testing.T) {
+	assert.Equal(t, "coloredbright", normalizeName(" Style_Colored-Bright "))
+	assert.Equal(t, "style", normalizeName("Style"))
+	assert.Equal(t, "light", normalizeName("light"))
+}
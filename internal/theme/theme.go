@@ -0,0 +1,112 @@
+// Package theme contains the logic shared by the table, list and progress
+// packages to encode their Styles as JSON themes.
+package theme
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+
+	"github.com/jedib0t/go-pretty/v6/text"
+)
+
+// namedTypes maps the text enumerations to the types that encode them using
+// their names in the themes. The text enumerations get encoded as numbers by
+// encoding/json otherwise.
+var namedTypes = map[reflect.Type]reflect.Type{
+	reflect.TypeOf(text.Align(0)):  reflect.TypeOf(align(0)),
+	reflect.TypeOf(text.Color(0)):  reflect.TypeOf(color(0)),
+	reflect.TypeOf(text.Format(0)): reflect.TypeOf(format(0)),
+	reflect.TypeOf(text.VAlign(0)): reflect.TypeOf(valign(0)),
+}
+
+// FromJSON decodes the JSON theme into the style, which has to be a pointer to
+// a Style struct. Anything not defined in the theme is left as it is in the
+// style.
+func FromJSON(data []byte, style interface{}) error {
+	styleValue := reflect.ValueOf(style).Elem()
+	themeValue := reflect.New(themeType(styleValue.Type())).Elem()
+	convert(themeValue, styleValue)
+	if err := json.Unmarshal(data, themeValue.Addr().Interface()); err != nil {
+		return err
+	}
+	convert(styleValue, themeValue)
+	return nil
+}
+
+// ToJSON returns the style as an indented JSON theme that can be loaded back
+// using FromJSON. HTML characters (like "&nbsp;" in table.HTMLOptions) are not
+// escaped.
+func ToJSON(style interface{}) ([]byte, error) {
+	styleValue := reflect.ValueOf(style)
+	themeValue := reflect.New(themeType(styleValue.Type())).Elem()
+	convert(themeValue, styleValue)
+
+	var out bytes.Buffer
+	encoder := json.NewEncoder(&out)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(themeValue.Interface()); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(out.Bytes(), []byte("\n")), nil
+}
+
+// convert copies the src value into dst, where one of them is of a type
+// returned by themeType for the type of the other.
+func convert(dst reflect.Value, src reflect.Value) {
+	switch {
+	case dst.Type() == src.Type():
+		dst.Set(src)
+	case dst.Kind() == reflect.Struct:
+		for idx := 0; idx < dst.NumField(); idx++ {
+			if field := dst.Type().Field(idx); field.PkgPath == "" {
+				convert(dst.Field(idx), src.FieldByName(field.Name))
+			}
+		}
+	case dst.Kind() == reflect.Slice:
+		if src.IsNil() {
+			dst.Set(reflect.Zero(dst.Type()))
+			return
+		}
+		dst.Set(reflect.MakeSlice(dst.Type(), src.Len(), src.Len()))
+		for idx := 0; idx < src.Len(); idx++ {
+			convert(dst.Index(idx), src.Index(idx))
+		}
+	default:
+		dst.Set(src.Convert(dst.Type()))
+	}
+}
+
+// themeType returns the type to use in place of the given type while encoding
+// it as a theme, with all the text enumerations in it replaced by the types
+// in namedTypes. Unexported struct fields are left out.
+func themeType(t reflect.Type) reflect.Type {
+	if namedType, ok := namedTypes[t]; ok {
+		return namedType
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		fields, changed := make([]reflect.StructField, 0, t.NumField()), false
+		for idx := 0; idx < t.NumField(); idx++ {
+			field := t.Field(idx)
+			if field.PkgPath != "" {
+				changed = true
+				continue
+			}
+			if fieldType := themeType(field.Type); fieldType != field.Type {
+				field.Type, changed = fieldType, true
+			}
+			fields = append(fields, reflect.StructField{Name: field.Name, Type: field.Type, Tag: field.Tag})
+		}
+		if changed {
+			return reflect.StructOf(fields)
+		}
+	case reflect.Slice:
+		if elemType := themeType(t.Elem()); elemType != t.Elem() {
+			return reflect.SliceOf(elemType)
+		}
+	}
+	return t
+}
@@ -0,0 +1,97 @@
+package theme
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/stretchr/testify/assert"
+)
+
+type testStyle struct {
+	Name    string
+	Box     testStyleBox
+	Colors  text.Colors
+	Format  text.Format
+	Align   text.Align
+	VAlign  text.VAlign
+	Timeout time.Duration
+	Func    func() `json:"-"`
+	private int
+}
+
+type testStyleBox struct {
+	Left       string
+	Background text.Color `json:",omitempty"`
+}
+
+func TestFromJSON(t *testing.T) {
+	style := testStyle{Name: "Default", Box: testStyleBox{Left: "|"}, Format: text.FormatUpper, private: 7}
+	err := FromJSON([]byte(`{
+		"Name": "MyStyle",
+		"Box": {"Background": "bg-256-17"},
+		"Colors": ["Bold", "fg-#ff8800"],
+		"Align": "center",
+		"VAlign": "bottom",
+		"Timeout": 1000
+	}`), &style)
+	assert.Nil(t, err)
+	assert.Equal(t, testStyle{
+		Name:    "MyStyle",
+		Box:     testStyleBox{Left: "|", Background: text.Bg256(17)},
+		Colors:  text.Colors{text.Bold, text.FgRGB(255, 136, 0)},
+		Format:  text.FormatUpper,
+		Align:   text.AlignCenter,
+		VAlign:  text.VAlignBottom,
+		Timeout: time.Microsecond,
+		private: 7,
+	}, style)
+
+	assert.EqualError(t, FromJSON([]byte(`{"Colors": ["foo"]}`), &style), `invalid color "foo"`)
+	err = FromJSON([]byte(`{"Format": 1}`), &style)
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "cannot unmarshal number")
+	}
+	assert.EqualError(t, FromJSON([]byte(`{`), &style), "unexpected end of JSON input")
+}
+
+func TestToJSON(t *testing.T) {
+	style := testStyle{
+		Name:   "<MyStyle>",
+		Box:    testStyleBox{Left: "&nbsp;"},
+		Colors: text.Colors{text.Bold, text.FgHiRed},
+		Align:  text.AlignRight,
+	}
+	data, err := ToJSON(style)
+	assert.Nil(t, err)
+	assert.Equal(t, `{
+  "Name": "<MyStyle>",
+  "Box": {
+    "Left": "&nbsp;"
+  },
+  "Colors": [
+    "bold",
+    "fg-hi-red"
+  ],
+  "Format": "default",
+  "Align": "right",
+  "VAlign": "default",
+  "Timeout": 0
+}`, string(data))
+
+	var styleUnmarshaled testStyle
+	assert.Nil(t, FromJSON(data, &styleUnmarshaled))
+	assert.Equal(t, style, styleUnmarshaled)
+
+	// the style itself still gets encoded with the numeric values
+	data, err = json.Marshal(style)
+	assert.Nil(t, err)
+	assert.Contains(t, string(data), `"Colors":[1,91],"Format":0,"Align":4`)
+
+	style.Colors = text.Colors{text.Color(12345)}
+	_, err = ToJSON(style)
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "invalid color 12345")
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"io"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/jedib0t/go-pretty/v6/text"
 )
 
 const (
@@ -23,6 +25,9 @@ type listItem struct {
 type List struct {
 	// approxSize stores the approximate output length/size
 	approxSize int
+	// colorProfile stores the text.ColorProfile to render with; the global one
+	// is used if not set
+	colorProfile *text.ColorProfile
 	// htmlCSSClass stores the HTML CSS Class to use on the <ul> node
 	htmlCSSClass string
 	// items contains the list of items to render
@@ -71,6 +76,14 @@ func (l *List) Reset() {
 	l.style = nil
 }
 
+// SetColorProfile sets the text.ColorProfile to render with instead of the
+// global one (see text.SetColorProfile); colors (in the items) not supported
+// by the profile get downgraded to the nearest supported color, and all escape
+// sequences get stripped for text.ColorProfileNone.
+func (l *List) SetColorProfile(profile text.ColorProfile) {
+	l.colorProfile = &profile
+}
+
 // SetHTMLCSSClass sets the the HTML CSS Class to use on the <ul> node
 // when rendering the List in HTML format. Recursive lists would use a numbered
 // index suffix. For ex., if the cssClass is set as "foo"; the <ul> for level 0
@@ -155,8 +168,15 @@ func (l *List) hasMoreItemsInLevel(levelIdx int, fromItemIdx int) bool {
 	return false
 }
 
+func (l *List) getColorProfile() text.ColorProfile {
+	if l.colorProfile != nil {
+		return *l.colorProfile
+	}
+	return text.GetColorProfile()
+}
+
 func (l *List) render(out *strings.Builder) string {
-	outStr := out.String()
+	outStr := l.getColorProfile().Convert(out.String())
 	if l.outputMirror != nil && len(outStr) > 0 {
 		l.outputMirror.Write([]byte(outStr))
 		l.outputMirror.Write([]byte("\n"))
@@ -1,8 +1,10 @@
 package list
 
 import (
+	"sync"
 	"testing"
 
+	"github.com/jedib0t/go-pretty/v6/text"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -19,6 +21,10 @@ var (
 	testItem5    = "The Gunslinger"
 )
 
+func init() {
+	text.EnableColors()
+}
+
 type myMockOutputMirror struct {
 	mirroredOutput string
 }
@@ -93,6 +99,64 @@ func TestList_Reset(t *testing.T) {
 	assert.Equal(t, "", list.Render())
 }
 
+func TestList_SetColorProfile(t *testing.T) {
+	list := List{}
+	list.AppendItem(text.FgRGB(255, 0, 0).Sprint("Game Of Thrones"))
+	list.AppendItem(text.Hyperlink("https://github.com", "GitHub"))
+	assert.Nil(t, list.colorProfile)
+	assert.Equal(t, "* \x1b[38;2;255;0;0mGame Of Thrones\x1b[0m\n* \x1b]8;;https://github.com\x1b\\GitHub\x1b]8;;\x1b\\", list.Render())
+
+	list.SetColorProfile(text.ColorProfileANSI)
+	assert.Equal(t, "* \x1b[91mGame Of Thrones\x1b[0m\n* \x1b]8;;https://github.com\x1b\\GitHub\x1b]8;;\x1b\\", list.Render())
+
+	mockOutputMirror := &myMockOutputMirror{}
+	list.SetColorProfile(text.ColorProfileNone)
+	list.SetOutputMirror(mockOutputMirror)
+	assert.Equal(t, "* Game Of Thrones\n* GitHub", list.Render())
+	assert.Equal(t, "* Game Of Thrones\n* GitHub\n", mockOutputMirror.mirroredOutput)
+}
+
+func TestList_SetColorProfile_Concurrency(t *testing.T) {
+	defer text.EnableColors()
+
+	newList := func(profile text.ColorProfile) *List {
+		list := &List{}
+		list.AppendItem(text.FgRGB(255, 0, 0).Sprint(testItem1))
+		list.Indent()
+		list.AppendItems(testItems2)
+		list.SetColorProfile(profile)
+		return list
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(3)
+	go func() {
+		defer wg.Done()
+		for idx := 0; idx < 50; idx++ {
+			if idx%2 == 0 {
+				text.DisableColors()
+			} else {
+				text.EnableColors()
+			}
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		list := newList(text.ColorProfileANSI)
+		for idx := 0; idx < 50; idx++ {
+			assert.Equal(t, "* \x1b[91mGame Of Thrones\x1b[0m\n  * Winter\n  * Is\n  * Coming", list.Render())
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		list := newList(text.ColorProfileNone)
+		for idx := 0; idx < 50; idx++ {
+			assert.Equal(t, "* Game Of Thrones\n  * Winter\n  * Is\n  * Coming", list.Render())
+		}
+	}()
+	wg.Wait()
+}
+
 func TestList_SetHTMLCSSClass(t *testing.T) {
 	list := List{}
 	assert.Empty(t, list.htmlCSSClass)
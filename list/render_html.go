@@ -4,6 +4,8 @@ import (
 	"html"
 	"strconv"
 	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/text"
 )
 
 // RenderHTML renders the List in the HTML format. Example:
@@ -50,7 +52,7 @@ func (l *List) htmlRenderRecursively(out *strings.Builder, idx int, item *listIt
 		if l.items[itemIdx].Level == item.Level {
 			out.WriteString(linePrefix)
 			out.WriteString("  <li>")
-			out.WriteString(strings.Replace(html.EscapeString(l.items[itemIdx].Text), "\n", "<br/>", -1))
+			out.WriteString(l.htmlEscape(l.items[itemIdx].Text))
 			out.WriteString("</li>\n")
 			numItemsRendered++
 		} else if l.items[itemIdx].Level > item.Level { // indent
@@ -68,3 +70,17 @@ func (l *List) htmlRenderRecursively(out *strings.Builder, idx int, item *listIt
 	out.WriteString("</ul>")
 	return numItemsRendered
 }
+
+func (l *List) htmlEscape(str string) string {
+	str = html.EscapeString(str)
+	if l.style.HTML.ConvertANSI {
+		if l.style.HTML.InlineCSS {
+			str = text.ANSIToHTMLInline(str)
+		} else {
+			str = text.ANSIToHTML(str)
+		}
+	}
+	str = strings.Replace(str, "\n", "<br/>", -1)
+	return text.ReplaceHyperlinks(str, text.HTMLHyperlink)
+}
+
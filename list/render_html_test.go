@@ -3,6 +3,7 @@ package list
 import (
 	"testing"
 
+	"github.com/jedib0t/go-pretty/v6/text"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -105,3 +106,39 @@ func TestList_RenderHTML_Complex(t *testing.T) {
 
 	assert.Equal(t, expectedOut, lw.RenderHTML())
 }
+
+func TestList_RenderHTML_Hyperlinks(t *testing.T) {
+	lw := NewWriter()
+	lw.AppendItem("Visit " + text.Hyperlink("https://github.com/jedib0t/go-pretty?a=1&b=2", "go-pretty") + " now!")
+	lw.AppendItem(text.Hyperlink("https://github.com", ""))
+
+	expectedOut := `<ul class="go-pretty-table">
+  <li>Visit <a href="https://github.com/jedib0t/go-pretty?a=1&amp;b=2">go-pretty</a> now!</li>
+  <li><a href="https://github.com">https://github.com</a></li>
+</ul>`
+
+	assert.Equal(t, expectedOut, lw.RenderHTML())
+}
+
+func TestList_RenderHTML_ConvertANSI(t *testing.T) {
+	lw := NewWriter()
+	lw.AppendItem(text.FgRed.Sprint("Game") + " <of> " + text.Bold.Sprint("Thrones"))
+	lw.AppendItem(text.Hyperlink("https://github.com", text.FgHiBlue.Sprint("GitHub")))
+	lw.AppendItem(text.BgRGB(0x10, 0x20, 0x30).Sprint("Winter\nIs Coming"))
+	lw.Style().HTML.ConvertANSI = true
+
+	expectedOut := `<ul class="go-pretty-table">
+  <li><span class="fg-red">Game</span> &lt;of&gt; <span class="bold">Thrones</span></li>
+  <li><a href="https://github.com"><span class="fg-hi-blue">GitHub</span></a></li>
+  <li><span style="background-color: #102030">Winter<br/>Is Coming</span></li>
+</ul>`
+	assert.Equal(t, expectedOut, lw.RenderHTML())
+
+	lw.Style().HTML.InlineCSS = true
+	expectedOut = `<ul class="go-pretty-table">
+  <li><span style="color: #cd0000">Game</span> &lt;of&gt; <span style="font-weight: bold">Thrones</span></li>
+  <li><a href="https://github.com"><span style="color: #5c5cff">GitHub</span></a></li>
+  <li><span style="background-color: #102030">Winter<br/>Is Coming</span></li>
+</ul>`
+	assert.Equal(t, expectedOut, lw.RenderHTML())
+}
@@ -12,6 +12,7 @@ type Style struct {
 	CharItemVertical string      // the vertical connector from one bullet to the next
 	CharItemBottom   string      // the bullet for the bottom-most item
 	CharNewline      string      // new-line character to use
+	HTML             HTMLOptions // rendering options for HTML mode
 	LinePrefix       string      // prefix for every single line
 	Name             string      // name of the Style
 }
@@ -293,3 +294,9 @@ var (
 		Name:             "styleTest",
 	}
 )
+
+// HTMLOptions defines the options to control HTML rendering.
+type HTMLOptions struct {
+	ConvertANSI bool // convert ANSI escape sequences (colors) in the items into HTML (see text.ANSIToHTML)?
+	InlineCSS   bool // render the converted colors using inline styles instead of CSS classes?
+}
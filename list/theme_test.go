@@ -0,0 +1,90 @@
+package list
+
+import (
+	"testing"
+
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegisterStyle(t *testing.T) {
+	defer stylesRegistry.Unregister("StyleTest")
+
+	assert.EqualError(t, RegisterStyle(Style{}), "cannot register a style without a name")
+
+	style := StyleBulletCircle
+	style.Name = "StyleTest"
+	assert.Nil(t, RegisterStyle(style))
+	styleRegistered, ok := StyleByName("test")
+	assert.True(t, ok)
+	assert.Equal(t, style, styleRegistered)
+	assert.Contains(t, StyleNames(), "StyleTest")
+}
+
+func TestStyleByName(t *testing.T) {
+	for _, name := range []string{"StyleConnectedRounded", "connectedrounded", "Connected-Rounded"} {
+		style, ok := StyleByName(name)
+		assert.True(t, ok, name)
+		assert.Equal(t, StyleConnectedRounded, style, name)
+	}
+
+	_, ok := StyleByName("foo")
+	assert.False(t, ok)
+}
+
+func TestStyleFromJSON(t *testing.T) {
+	style, err := StyleFromJSON([]byte(`{
+		"Name": "MyStyle",
+		"Format": "upper",
+		"CharItemSingle": "-",
+		"CharItemTop": "-",
+		"CharItemFirst": "-",
+		"CharItemMiddle": "-",
+		"CharItemBottom": "-"
+	}`))
+	assert.Nil(t, err)
+
+	expectedStyle := StyleDefault
+	expectedStyle.Name = "MyStyle"
+	expectedStyle.Format = text.FormatUpper
+	expectedStyle.CharItemSingle = "-"
+	expectedStyle.CharItemTop = "-"
+	expectedStyle.CharItemFirst = "-"
+	expectedStyle.CharItemMiddle = "-"
+	expectedStyle.CharItemBottom = "-"
+	assert.Equal(t, expectedStyle, style)
+
+	list := List{}
+	list.AppendItem(testItem1)
+	list.Indent()
+	list.AppendItems(testItems2)
+	list.SetStyle(style)
+	assert.Equal(t, "- GAME OF THRONES\n  - WINTER\n  - IS\n  - COMING", list.Render())
+
+	_, err = StyleFromJSON([]byte(`{"Format": "foo"}`))
+	assert.EqualError(t, err, `failed to parse style: invalid format "foo"`)
+}
+
+func TestStyleNames(t *testing.T) {
+	names := StyleNames()
+	assert.Len(t, names, 11)
+	assert.Equal(t, "StyleBulletCircle", names[0])
+	assert.Equal(t, "StyleMarkdown", names[len(names)-1])
+}
+
+func TestStyle_JSON(t *testing.T) {
+	for _, name := range StyleNames() {
+		style, _ := StyleByName(name)
+		data, err := style.JSON()
+		assert.Nil(t, err, name)
+
+		styleUnmarshaled, err := StyleFromJSON(data)
+		assert.Nil(t, err, name)
+		assert.Equal(t, style, styleUnmarshaled, name)
+	}
+
+	data, err := StyleConnectedLight.JSON()
+	assert.Nil(t, err)
+	assert.Contains(t, string(data), `"Format": "default",`)
+	assert.Contains(t, string(data), `"CharItemTop": "┌─",`)
+}
@@ -1,6 +1,10 @@
 package list
 
-import "io"
+import (
+	"io"
+
+	"github.com/jedib0t/go-pretty/v6/text"
+)
 
 // Writer declares the interfaces that can be used to setup and render a list.
 type Writer interface {
@@ -12,6 +16,7 @@ type Writer interface {
 	RenderHTML() string
 	RenderMarkdown() string
 	Reset()
+	SetColorProfile(profile text.ColorProfile)
 	SetHTMLCSSClass(cssClass string)
 	SetOutputMirror(mirror io.Writer)
 	SetStyle(style Style)
@@ -56,6 +56,14 @@ func IndeterminateIndicatorPacMan(duration time.Duration) IndeterminateIndicator
 	return timedIndeterminateIndicatorGenerator(indeterminateIndicatorPacMan(), duration)
 }
 
+// IndeterminateIndicatorSpinner cycles through the frames of the Spinner (in
+// the middle of the progress bar) for each specified duration. If duration is
+// 0, then every single invocation moves the spinner to the next frame. An
+// empty Spinner is replaced with SpinnerLine.
+func IndeterminateIndicatorSpinner(spinner Spinner, duration time.Duration) IndeterminateIndicatorGenerator {
+	return timedIndeterminateIndicatorGenerator(indeterminateIndicatorSpinner(spinner), duration)
+}
+
 func indeterminateIndicatorDominoes() IndeterminateIndicatorGenerator {
 	direction := 1 // positive == left to right; negative == right to left
 	nextPosition := 0
@@ -70,13 +78,16 @@ func indeterminateIndicatorDominoes() IndeterminateIndicatorGenerator {
 
 	return func(maxLen int) IndeterminateIndicator {
 		currentPosition := nextPosition
+		if currentPosition > maxLen { // the progress bar got shorter
+			currentPosition = maxLen
+		}
 
 		if currentPosition == 0 {
 			direction = 1
 		} else if currentPosition == maxLen {
 			direction = -1
 		}
-		nextPosition += direction
+		nextPosition = currentPosition + direction
 
 		return IndeterminateIndicator{
 			Position: 0,
@@ -91,13 +102,16 @@ func indeterminateIndicatorMovingBackAndForth(indicator string) IndeterminateInd
 
 	return func(maxLen int) IndeterminateIndicator {
 		currentPosition := nextPosition
+		if currentPosition+text.RuneCount(indicator) > maxLen { // the progress bar got shorter
+			currentPosition = maxInt(maxLen-text.RuneCount(indicator), 0)
+		}
 
 		if currentPosition == 0 {
 			direction = 1
 		} else if currentPosition+text.RuneCount(indicator) == maxLen {
 			direction = -1
 		}
-		nextPosition += direction
+		nextPosition = currentPosition + direction
 
 		return IndeterminateIndicator{
 			Position: currentPosition,
@@ -111,8 +125,11 @@ func indeterminateIndicatorMovingLeftToRight(indicator string) IndeterminateIndi
 
 	return func(maxLen int) IndeterminateIndicator {
 		currentPosition := nextPosition
+		if currentPosition+text.RuneCount(indicator) > maxLen { // the progress bar got shorter
+			currentPosition = maxInt(maxLen-text.RuneCount(indicator), 0)
+		}
 
-		nextPosition++
+		nextPosition = currentPosition + 1
 		if nextPosition+text.RuneCount(indicator) > maxLen {
 			nextPosition = 0
 		}
@@ -132,7 +149,10 @@ func indeterminateIndicatorMovingRightToLeft(indicator string) IndeterminateIndi
 			nextPosition = maxLen - text.RuneCount(indicator)
 		}
 		currentPosition := nextPosition
-		nextPosition--
+		if currentPosition+text.RuneCount(indicator) > maxLen { // the progress bar got shorter
+			currentPosition = maxInt(maxLen-text.RuneCount(indicator), 0)
+		}
+		nextPosition = currentPosition - 1
 
 		return IndeterminateIndicator{
 			Position: currentPosition,
@@ -154,12 +174,17 @@ func indeterminateIndicatorPacMan() IndeterminateIndicatorGenerator {
 			out.WriteString(strings.Repeat(" ", currentPosition))
 		}
 		out.WriteString(indicator)
-		out.WriteString(strings.Repeat(" ", maxLen-currentPosition-1))
+		if maxLen-currentPosition-1 > 0 {
+			out.WriteString(strings.Repeat(" ", maxLen-currentPosition-1))
+		}
 		return out.String()
 	}
 
 	return func(maxLen int) IndeterminateIndicator {
 		currentPosition := nextPosition
+		if currentPosition+text.RuneCount(indicator) > maxLen { // the progress bar got shorter
+			currentPosition = maxInt(maxLen-text.RuneCount(indicator), 0)
+		}
 		currentText := generateIndicator(currentPosition, maxLen)
 
 		if currentPosition == 0 {
@@ -169,7 +194,7 @@ func indeterminateIndicatorPacMan() IndeterminateIndicatorGenerator {
 			direction = -1
 			indicator = pacManMovingLeft
 		}
-		nextPosition += direction
+		nextPosition = currentPosition + direction
 
 		return IndeterminateIndicator{
 			Position: 0,
@@ -178,6 +203,27 @@ func indeterminateIndicatorPacMan() IndeterminateIndicatorGenerator {
 	}
 }
 
+func indeterminateIndicatorSpinner(spinner Spinner) IndeterminateIndicatorGenerator {
+	if len(spinner) == 0 {
+		spinner = SpinnerLine
+	}
+	nextFrame := 0
+
+	return func(maxLen int) IndeterminateIndicator {
+		frame := spinner[nextFrame]
+		nextFrame = (nextFrame + 1) % len(spinner)
+
+		position := (maxLen - text.RuneCount(frame)) / 2
+		if position < 0 {
+			position = 0
+		}
+		return IndeterminateIndicator{
+			Position: position,
+			Text:     frame,
+		}
+	}
+}
+
 // timedIndeterminateIndicatorGenerator ticks based on the given duration. If
 // duration is 0, it ticks for every invocation.
 func timedIndeterminateIndicatorGenerator(indicatorGenerator IndeterminateIndicatorGenerator, duration time.Duration) IndeterminateIndicatorGenerator {
@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/jedib0t/go-pretty/v6/text"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -286,3 +287,55 @@ func TestIndeterminateIndicatorPacMan(t *testing.T) {
 		fmt.Println(out.String())
 	}
 }
+
+func TestIndeterminateIndicatorSpinner(t *testing.T) {
+	maxLen := 10
+	expectedTexts := []string{"-", "\\", "|", "/", "-", "\\", "|", "/"}
+
+	f := IndeterminateIndicatorSpinner(SpinnerLine, time.Millisecond*10)
+	for idx, expectedText := range expectedTexts {
+		actual := f(maxLen)
+		assert.Equal(t, 4, actual.Position, fmt.Sprintf("expectedTexts[%d]", idx))
+		assert.Equal(t, expectedText, actual.Text, fmt.Sprintf("expectedTexts[%d]", idx))
+		time.Sleep(time.Millisecond * 10)
+	}
+}
+
+func Test_indeterminateIndicatorSpinner(t *testing.T) {
+	f := indeterminateIndicatorSpinner(Spinner{"<==>"})
+	assert.Equal(t, IndeterminateIndicator{Position: 3, Text: "<==>"}, f(10))
+	assert.Equal(t, IndeterminateIndicator{Position: 0, Text: "<==>"}, f(4))
+	assert.Equal(t, IndeterminateIndicator{Position: 0, Text: "<==>"}, f(2))
+
+	// an empty spinner falls back to SpinnerLine
+	for _, spinner := range []Spinner{nil, {}} {
+		f = indeterminateIndicatorSpinner(spinner)
+		assert.Equal(t, IndeterminateIndicator{Position: 4, Text: "-"}, f(10))
+		assert.Equal(t, IndeterminateIndicator{Position: 4, Text: "\\"}, f(10))
+	}
+	assert.NotPanics(t, func() {
+		IndeterminateIndicatorSpinner(Spinner{}, 0)(10)
+	})
+}
+
+func Test_indeterminateIndicators_Shrink(t *testing.T) {
+	// the progress bar may get shorter as the terminal gets narrower
+	generators := map[string]IndeterminateIndicatorGenerator{
+		"Dominoes":     indeterminateIndicatorDominoes(),
+		"BackAndForth": indeterminateIndicatorMovingBackAndForth("<=>"),
+		"LeftToRight":  indeterminateIndicatorMovingLeftToRight("<=>"),
+		"PacMan":       indeterminateIndicatorPacMan(),
+		"RightToLeft":  indeterminateIndicatorMovingRightToLeft("<=>"),
+		"Spinner":      indeterminateIndicatorSpinner(SpinnerLine),
+	}
+	for name, generator := range generators {
+		for idx := 0; idx < 8; idx++ {
+			generator(10)
+		}
+		for maxLen := 9; maxLen >= 3; maxLen-- {
+			actual := generator(maxLen)
+			assert.True(t, actual.Position+text.RuneCount(actual.Text) <= maxLen,
+				fmt.Sprintf("%s: %#v for maxLen %d", name, actual, maxLen))
+		}
+	}
+}
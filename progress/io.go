@@ -0,0 +1,195 @@
+package progress
+
+import (
+	"fmt"
+	"io"
+	"os"
+)
+
+// Reader wraps an io.Reader and increments the Tracker with the number of
+// bytes read. The Tracker is marked as done when the io.Reader returns io.EOF,
+// and as errored when it returns any other error.
+type Reader struct {
+	reader  io.Reader
+	start   int64 // offset of the io.Reader (if an io.Seeker) when wrapped
+	tracker *Tracker
+}
+
+// NewReader returns a Reader that reads from the given io.Reader and drives
+// the given Tracker. If the Tracker does not have a Total set, it is set to the
+// size of the data (if it can be determined; ex.: for a *bytes.Reader,
+// *strings.Reader, *os.File, or any io.Seeker), and if it does not have Units
+// set, they are set to UnitsBytes. The Total covers only the data left to be
+// read from the current offset of the io.Reader, and so does the value of the
+// Tracker. For ex.:
+//  tracker := &progress.Tracker{Message: "Downloading " + fileName}
+//  pw.AppendTracker(tracker)
+//  _, err := io.Copy(file, progress.NewReader(resp.Body, tracker))
+func NewReader(r io.Reader, t *Tracker) *Reader {
+	t.mutex.Lock()
+	if t.Total == 0 {
+		t.Total = ioSize(r)
+	}
+	if t.Units.Formatter == nil && t.Units.Notation == "" {
+		t.Units = UnitsBytes
+	}
+	t.mutex.Unlock()
+
+	var start int64
+	if seeker, ok := r.(io.Seeker); ok {
+		if pos, err := seeker.Seek(0, io.SeekCurrent); err == nil {
+			start = pos
+		}
+	}
+	return &Reader{reader: r, start: start, tracker: t}
+}
+
+// Close closes the underlying io.Reader if it is an io.Closer.
+func (r *Reader) Close() error {
+	if closer, ok := r.reader.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
+// Read reads from the underlying io.Reader and increments the Tracker.
+func (r *Reader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.tracker.Increment(int64(n))
+	if err == io.EOF {
+		r.tracker.MarkAsDone()
+	} else if err != nil {
+		r.tracker.MarkAsErrored(err)
+	}
+	return n, err
+}
+
+// ReadAt reads from the underlying io.ReaderAt (returns an error if the
+// io.Reader is not one) and increments the Tracker. Reading beyond the end of
+// the data (io.EOF) does not mark the Tracker as done, as the reads may happen
+// in any order. The Tracker is incremented by the number of bytes read in each
+// call irrespective of the offset, and so bytes read both using ReadAt and
+// Read (or read more than once using ReadAt) are counted more than once; use
+// only one of them to read the data.
+func (r *Reader) ReadAt(p []byte, off int64) (int, error) {
+	readerAt, ok := r.reader.(io.ReaderAt)
+	if !ok {
+		return 0, fmt.Errorf("progress: %T is not an io.ReaderAt", r.reader)
+	}
+
+	n, err := readerAt.ReadAt(p, off)
+	r.tracker.Increment(int64(n))
+	if err != nil && err != io.EOF {
+		r.tracker.MarkAsErrored(err)
+	}
+	return n, err
+}
+
+// Seek seeks within the underlying io.Seeker (returns an error if the
+// io.Reader is not one), and sets the value of the Tracker to the new offset
+// relative to the offset of the io.Seeker when it was wrapped by NewReader.
+func (r *Reader) Seek(offset int64, whence int) (int64, error) {
+	seeker, ok := r.reader.(io.Seeker)
+	if !ok {
+		return 0, fmt.Errorf("progress: %T is not an io.Seeker", r.reader)
+	}
+
+	pos, err := seeker.Seek(offset, whence)
+	if err != nil {
+		r.tracker.MarkAsErrored(err)
+	} else {
+		r.tracker.SetValue(ioMax(pos-r.start, 0))
+	}
+	return pos, err
+}
+
+// IOWriter wraps an io.Writer and increments the Tracker with the number of
+// bytes written. The Tracker is marked as errored when the io.Writer returns
+// an error, and as done when the IOWriter is closed (or when the Total is
+// reached).
+type IOWriter struct {
+	tracker *Tracker
+	writer  io.Writer
+}
+
+// NewIOWriter returns an IOWriter that writes to the given io.Writer and
+// drives the given Tracker. If the Tracker does not have Units set, they are
+// set to UnitsBytes. For ex.:
+//  tracker := &progress.Tracker{Message: "Uploading " + fileName, Total: size}
+//  pw.AppendTracker(tracker)
+//  w := progress.NewIOWriter(conn, tracker)
+//  _, err := io.Copy(w, file)
+//  w.Close()
+func NewIOWriter(w io.Writer, t *Tracker) *IOWriter {
+	t.mutex.Lock()
+	if t.Units.Formatter == nil && t.Units.Notation == "" {
+		t.Units = UnitsBytes
+	}
+	t.mutex.Unlock()
+
+	return &IOWriter{tracker: t, writer: w}
+}
+
+// Close closes the underlying io.Writer if it is an io.Closer, and marks the
+// Tracker as done (or errored if closing fails).
+func (w *IOWriter) Close() error {
+	var err error
+	if closer, ok := w.writer.(io.Closer); ok {
+		err = closer.Close()
+	}
+	if err != nil {
+		w.tracker.MarkAsErrored(err)
+	} else {
+		w.tracker.MarkAsDone()
+	}
+	return err
+}
+
+// Write writes to the underlying io.Writer and increments the Tracker.
+func (w *IOWriter) Write(p []byte) (int, error) {
+	n, err := w.writer.Write(p)
+	w.tracker.Increment(int64(n))
+	if err != nil {
+		w.tracker.MarkAsErrored(err)
+	}
+	return n, err
+}
+
+func ioMax(a, b int64) int64 {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// ioSize returns the number of bytes left to be read from the io.Reader, or
+// 0 if it cannot be determined.
+func ioSize(r io.Reader) int64 {
+	switch reader := r.(type) {
+	case interface{ Len() int }:
+		return int64(reader.Len())
+	case interface{ Stat() (os.FileInfo, error) }:
+		if info, err := reader.Stat(); err == nil && info.Mode().IsRegular() {
+			if seeker, ok := r.(io.Seeker); ok {
+				if pos, err := seeker.Seek(0, io.SeekCurrent); err == nil {
+					return info.Size() - pos
+				}
+			}
+			return info.Size()
+		}
+	case io.Seeker:
+		pos, err := reader.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return 0
+		}
+		end, err := reader.Seek(0, io.SeekEnd)
+		if err != nil {
+			return 0
+		}
+		if _, err := reader.Seek(pos, io.SeekStart); err != nil {
+			return 0
+		}
+		return end - pos
+	}
+	return 0
+}
@@ -0,0 +1,254 @@
+package progress
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type ioFailingReader struct {
+	data []byte
+	err  error
+}
+
+func (f *ioFailingReader) Read(p []byte) (int, error) {
+	if len(f.data) == 0 {
+		return 0, f.err
+	}
+	n := copy(p, f.data)
+	f.data = f.data[n:]
+	return n, nil
+}
+
+type ioFailingWriter struct {
+	closeErr error
+	closed   bool
+	limit    int
+}
+
+func (f *ioFailingWriter) Close() error {
+	f.closed = true
+	return f.closeErr
+}
+
+func (f *ioFailingWriter) Write(p []byte) (int, error) {
+	if len(p) > f.limit {
+		n := f.limit
+		f.limit = 0
+		return n, io.ErrShortWrite
+	}
+	f.limit -= len(p)
+	return len(p), nil
+}
+
+func TestNewReader(t *testing.T) {
+	tracker := &Tracker{}
+	reader := NewReader(strings.NewReader("hello world"), tracker)
+	assert.NotNil(t, reader)
+	assert.Equal(t, int64(11), tracker.Total)
+	assert.NotNil(t, tracker.Units.Formatter)
+	assert.Equal(t, "11B", tracker.Units.Sprint(11))
+
+	tracker = &Tracker{Total: 100, Units: UnitsDefault}
+	NewReader(bytes.NewReader([]byte("hello")), tracker)
+	assert.Equal(t, int64(100), tracker.Total)
+	assert.Equal(t, "5", tracker.Units.Sprint(5))
+
+	tracker = &Tracker{}
+	NewReader(&ioFailingReader{data: []byte("hello")}, tracker)
+	assert.Equal(t, int64(0), tracker.Total)
+	assert.True(t, tracker.IsIndeterminate())
+
+	file, err := ioutil.TempFile("", "go-pretty-progress-")
+	if assert.Nil(t, err) {
+		defer os.Remove(file.Name())
+		defer file.Close()
+		_, _ = file.WriteString("hello world")
+		_, _ = file.Seek(6, io.SeekStart)
+
+		tracker = &Tracker{}
+		NewReader(file, tracker)
+		assert.Equal(t, int64(5), tracker.Total)
+	}
+}
+
+func TestReader_Close(t *testing.T) {
+	reader := NewReader(strings.NewReader("hello"), &Tracker{})
+	assert.Nil(t, reader.Close())
+
+	writer := &ioFailingWriter{closeErr: errors.New("close failed")}
+	reader = NewReader(struct {
+		io.Reader
+		io.Closer
+	}{strings.NewReader("hello"), writer}, &Tracker{})
+	assert.Equal(t, writer.closeErr, reader.Close())
+	assert.True(t, writer.closed)
+}
+
+func TestReader_Read(t *testing.T) {
+	tracker := &Tracker{}
+	reader := NewReader(strings.NewReader("hello world"), tracker)
+
+	buf := make([]byte, 5)
+	n, err := reader.Read(buf)
+	assert.Equal(t, 5, n)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(5), tracker.Value())
+	assert.False(t, tracker.IsDone())
+
+	data, err := ioutil.ReadAll(reader)
+	assert.Nil(t, err)
+	assert.Equal(t, " world", string(data))
+	assert.Equal(t, int64(11), tracker.Value())
+	assert.True(t, tracker.IsDone())
+	assert.False(t, tracker.IsErrored())
+
+	tracker = &Tracker{}
+	reader = NewReader(&ioFailingReader{data: []byte("hello"), err: errors.New("connection reset")}, tracker)
+	data, err = ioutil.ReadAll(reader)
+	assert.NotNil(t, err)
+	assert.Equal(t, "hello", string(data))
+	assert.Equal(t, int64(5), tracker.Value())
+	assert.True(t, tracker.IsDone())
+	assert.True(t, tracker.IsErrored())
+	assert.Equal(t, err, tracker.Err())
+}
+
+func TestReader_ReadAt(t *testing.T) {
+	tracker := &Tracker{}
+	reader := NewReader(strings.NewReader("hello world"), tracker)
+
+	buf := make([]byte, 5)
+	n, err := reader.ReadAt(buf, 6)
+	assert.Equal(t, 5, n)
+	assert.Nil(t, err)
+	assert.Equal(t, "world", string(buf))
+	assert.Equal(t, int64(5), tracker.Value())
+
+	n, err = reader.ReadAt(buf, 8)
+	assert.Equal(t, 3, n)
+	assert.Equal(t, io.EOF, err)
+	assert.Equal(t, int64(8), tracker.Value())
+	assert.False(t, tracker.IsErrored())
+
+	n, err = reader.ReadAt(buf, -1)
+	assert.Equal(t, 0, n)
+	assert.NotNil(t, err)
+	assert.True(t, tracker.IsErrored())
+
+	reader = NewReader(&ioFailingReader{}, &Tracker{})
+	n, err = reader.ReadAt(buf, 0)
+	assert.Equal(t, 0, n)
+	assert.NotNil(t, err)
+}
+
+func TestReader_Seek(t *testing.T) {
+	tracker := &Tracker{}
+	reader := NewReader(strings.NewReader("hello world"), tracker)
+
+	pos, err := reader.Seek(6, io.SeekStart)
+	assert.Equal(t, int64(6), pos)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(6), tracker.Value())
+
+	data, err := ioutil.ReadAll(reader)
+	assert.Nil(t, err)
+	assert.Equal(t, "world", string(data))
+	assert.Equal(t, int64(11), tracker.Value())
+	assert.True(t, tracker.IsDone())
+
+	tracker = &Tracker{}
+	reader = NewReader(strings.NewReader("hello world"), tracker)
+	_, err = reader.Seek(-1, io.SeekStart)
+	assert.NotNil(t, err)
+	assert.True(t, tracker.IsErrored())
+
+	reader = NewReader(&ioFailingReader{}, &Tracker{})
+	_, err = reader.Seek(0, io.SeekStart)
+	assert.NotNil(t, err)
+}
+
+func TestReader_Seek_PartlyRead(t *testing.T) {
+	stringsReader := strings.NewReader("hello world")
+	_, _ = stringsReader.Seek(6, io.SeekStart)
+	tracker := &Tracker{}
+	reader := NewReader(stringsReader, tracker)
+	assert.Equal(t, int64(5), tracker.Total)
+
+	pos, err := reader.Seek(2, io.SeekCurrent)
+	assert.Equal(t, int64(8), pos)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), tracker.Value())
+
+	data, err := ioutil.ReadAll(reader)
+	assert.Nil(t, err)
+	assert.Equal(t, "rld", string(data))
+	assert.Equal(t, int64(5), tracker.Value())
+	assert.True(t, tracker.IsDone())
+
+	tracker = &Tracker{}
+	reader = NewReader(stringsReader, tracker)
+	pos, err = reader.Seek(0, io.SeekStart)
+	assert.Equal(t, int64(0), pos)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), tracker.Value())
+}
+
+func TestNewIOWriter(t *testing.T) {
+	tracker := &Tracker{}
+	writer := NewIOWriter(&bytes.Buffer{}, tracker)
+	assert.NotNil(t, writer)
+	assert.Equal(t, int64(0), tracker.Total)
+	assert.Equal(t, "11B", tracker.Units.Sprint(11))
+
+	tracker = &Tracker{Units: UnitsDefault}
+	NewIOWriter(&bytes.Buffer{}, tracker)
+	assert.Equal(t, "11", tracker.Units.Sprint(11))
+}
+
+func TestIOWriter_Close(t *testing.T) {
+	tracker := &Tracker{}
+	writer := NewIOWriter(&bytes.Buffer{}, tracker)
+	assert.Nil(t, writer.Close())
+	assert.True(t, tracker.IsDone())
+	assert.False(t, tracker.IsErrored())
+
+	tracker = &Tracker{}
+	failingWriter := &ioFailingWriter{closeErr: errors.New("close failed")}
+	writer = NewIOWriter(failingWriter, tracker)
+	assert.Equal(t, failingWriter.closeErr, writer.Close())
+	assert.True(t, failingWriter.closed)
+	assert.True(t, tracker.IsErrored())
+	assert.Equal(t, failingWriter.closeErr, tracker.Err())
+}
+
+func TestIOWriter_Write(t *testing.T) {
+	buf := &bytes.Buffer{}
+	tracker := &Tracker{Total: 11}
+	writer := NewIOWriter(buf, tracker)
+
+	n, err := io.Copy(writer, strings.NewReader("hello"))
+	assert.Equal(t, int64(5), n)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(5), tracker.Value())
+	assert.False(t, tracker.IsDone())
+
+	_, _ = io.Copy(writer, strings.NewReader(" world"))
+	assert.Equal(t, "hello world", buf.String())
+	assert.Equal(t, int64(11), tracker.Value())
+	assert.True(t, tracker.IsDone())
+
+	tracker = &Tracker{}
+	writer = NewIOWriter(&ioFailingWriter{limit: 3}, tracker)
+	n2, err := writer.Write([]byte("hello"))
+	assert.Equal(t, 3, n2)
+	assert.Equal(t, io.ErrShortWrite, err)
+	assert.Equal(t, int64(3), tracker.Value())
+	assert.True(t, tracker.IsErrored())
+}
@@ -5,14 +5,21 @@ import (
 	"math"
 	"os"
 	"sync"
+	"text/template"
 	"time"
 	"unicode/utf8"
+
+	"github.com/jedib0t/go-pretty/v6/text"
 )
 
 var (
 	// DefaultLengthTracker defines a sane value for a Tracker's length.
 	DefaultLengthTracker = 20
 
+	// DefaultLogInterval defines a sane value for the minimum interval between
+	// two lines logged for the progress of a Tracker in RenderModeLog.
+	DefaultLogInterval = time.Second * 5
+
 	// DefaultUpdateFrequency defines a sane value for the frequency with which
 	// all the Tracker's get updated on the screen.
 	DefaultUpdateFrequency = time.Millisecond * 250
@@ -21,12 +28,18 @@ var (
 // Progress helps track progress for one or more tasks.
 type Progress struct {
 	autoStop              bool
+	colorProfile          *text.ColorProfile
 	done                  chan bool
 	lengthTracker         int
+	layout                *template.Template
 	lengthProgress        int
+	linesActive           []int
+	logInterval           time.Duration
+	logStates             map[*Tracker]trackerLogState
 	outputWriter          io.Writer
 	hideTime              bool
 	hideTracker           bool
+	hideDoneChildren      bool
 	hideValue             bool
 	hidePercentage        bool
 	messageWidth          int
@@ -35,10 +48,15 @@ type Progress struct {
 	overallTrackerMutex   sync.RWMutex
 	renderInProgress      bool
 	renderInProgressMutex sync.RWMutex
+	renderMode            RenderMode
+	renderModeInUse       RenderMode
 	showETA               bool
 	showOverallTracker    bool
+	showSpeed             bool
 	sortBy                SortBy
 	style                 *Style
+	terminalWidth         int
+	terminalWidthProvider func() int
 	trackerPosition       Position
 	trackersActive        []*Tracker
 	trackersActiveMutex   sync.RWMutex
@@ -61,6 +79,36 @@ const (
 	PositionRight
 )
 
+// RenderMode defines how the Trackers get rendered on the output.
+type RenderMode int
+
+const (
+	// RenderModeAuto uses RenderModeLog if the output is a file that is not a
+	// terminal (ex.: output redirected to a file or a pipe in CI), and
+	// RenderModeTerminal otherwise.
+	RenderModeAuto RenderMode = iota
+
+	// RenderModeTerminal redraws the Trackers in place using cursor movement
+	// and line erasing escape sequences.
+	RenderModeTerminal
+
+	// RenderModeLog prints a plain line (without any escape sequences) for a
+	// Tracker when it starts and ends, and at most once every log interval
+	// (see SetLogInterval) in between as it makes progress, followed by a
+	// summary of all the Trackers once rendering stops (wait for
+	// IsRenderInProgress to return false after calling Stop to be sure the
+	// summary got written). For ex.:
+	//  [42%] Downloading foo (1.26MB/3.00MB)
+	//  [done!] Downloading foo (3.00MB in 2.5s)
+	//  [summary] 1 trackers in 3s: 1 done
+	RenderModeLog
+
+	// RenderModeJSON emits a TrackerEvent as a line of JSON for every change
+	// in the state of a Tracker (added, updated, done, errored or cancelled),
+	// so that other processes can render the progress themselves.
+	RenderModeJSON
+)
+
 // AppendTracker appends a single Tracker for tracking. The Tracker gets added
 // to a queue, which gets picked up by the Render logic in the next rendering
 // cycle.
@@ -140,6 +188,21 @@ func (p *Progress) LengthDone() int {
 	return out
 }
 
+// LengthErrored returns the number of Trackers that are done tracking after
+// being marked as errored (see Tracker.MarkAsErrored).
+func (p *Progress) LengthErrored() int {
+	p.trackersDoneMutex.RLock()
+	out := 0
+	for _, tracker := range p.trackersDone {
+		if tracker.IsErrored() {
+			out++
+		}
+	}
+	p.trackersDoneMutex.RUnlock()
+
+	return out
+}
+
 // LengthInQueue returns the number of Trackers in queue to be actively tracked
 // (not tracking yet).
 func (p *Progress) LengthInQueue() int {
@@ -158,6 +221,21 @@ func (p *Progress) SetAutoStop(autoStop bool) {
 	p.autoStop = autoStop
 }
 
+// SetColorProfile sets the text.ColorProfile to render with instead of the
+// global one (see text.SetColorProfile); colors not supported by the profile
+// get downgraded to the nearest supported color, and are not rendered at all
+// for text.ColorProfileNone. For ex.:
+//  pw.SetColorProfile(text.DetectColorProfile(logFile))
+func (p *Progress) SetColorProfile(profile text.ColorProfile) {
+	p.colorProfile = &profile
+}
+
+// SetLogInterval sets the minimum interval between two lines logged for the
+// progress of a Tracker in RenderModeLog. A sane value would be 5s.
+func (p *Progress) SetLogInterval(interval time.Duration) {
+	p.logInterval = interval
+}
+
 // SetMessageWidth sets the (printed) length of the tracker message. Any message
 // longer the specified width will be snipped abruptly. Any message shorter than
 // the specified width will be padded with spaces.
@@ -178,6 +256,12 @@ func (p *Progress) SetOutputWriter(writer io.Writer) {
 	p.outputWriter = writer
 }
 
+// SetRenderMode sets the way the Trackers get rendered on the output; defaults
+// to RenderModeAuto.
+func (p *Progress) SetRenderMode(mode RenderMode) {
+	p.renderMode = mode
+}
+
 // SetSortBy defines the sorting mechanism to use to sort the Active Trackers
 // before rendering the. Default: no-sorting == sort-by-insertion-order.
 func (p *Progress) SetSortBy(sortBy SortBy) {
@@ -207,6 +291,12 @@ func (p *Progress) SetUpdateFrequency(frequency time.Duration) {
 	p.updateFrequency = frequency
 }
 
+// ShowDoneChildren toggles showing the child Trackers that are done under
+// their parent Tracker; hiding them collapses the finished sub-tasks.
+func (p *Progress) ShowDoneChildren(show bool) {
+	p.hideDoneChildren = !show
+}
+
 // ShowETA toggles showing the ETA for all individual trackers.
 func (p *Progress) ShowETA(show bool) {
 	p.showETA = show
@@ -222,6 +312,12 @@ func (p *Progress) ShowOverallTracker(show bool) {
 	p.showOverallTracker = show
 }
 
+// ShowSpeed toggles showing the Speed (rate of progress) of each Tracker, as
+// the Units per second (ex.: "12.30MB/s").
+func (p *Progress) ShowSpeed(show bool) {
+	p.showSpeed = show
+}
+
 // ShowTime toggles showing the Time taken by each Tracker.
 func (p *Progress) ShowTime(show bool) {
 	p.hideTime = !show
@@ -253,6 +349,27 @@ func (p *Progress) Style() *Style {
 	return p.style
 }
 
+// getAllTrackers returns all the Trackers; done, active, and in queue.
+func (p *Progress) getAllTrackers() []*Tracker {
+	p.trackersDoneMutex.RLock()
+	trackers := append([]*Tracker(nil), p.trackersDone...)
+	p.trackersDoneMutex.RUnlock()
+	p.trackersActiveMutex.RLock()
+	trackers = append(trackers, p.trackersActive...)
+	p.trackersActiveMutex.RUnlock()
+	p.trackersInQueueMutex.RLock()
+	trackers = append(trackers, p.trackersInQueue...)
+	p.trackersInQueueMutex.RUnlock()
+	return trackers
+}
+
+func (p *Progress) getColorProfile() text.ColorProfile {
+	if p.colorProfile != nil {
+		return *p.colorProfile
+	}
+	return text.GetColorProfile()
+}
+
 func (p *Progress) initForRender() {
 	// pick a default style
 	p.Style()
@@ -280,11 +397,43 @@ func (p *Progress) initForRender() {
 	if p.updateFrequency <= 0 {
 		p.updateFrequency = DefaultUpdateFrequency
 	}
+
+	// get the width of the terminal to fit the trackers in
+	if p.terminalWidthProvider == nil {
+		p.terminalWidthProvider = func() int {
+			return text.TerminalWidth(p.outputWriter)
+		}
+	}
+
+	// log plain lines if the output cannot handle cursor movements
+	p.renderModeInUse = p.renderMode
+	if p.renderMode == RenderModeAuto {
+		p.renderModeInUse = RenderModeTerminal
+		if file, isFile := p.outputWriter.(*os.File); isFile && !text.IsTerminal(file) {
+			p.renderModeInUse = RenderModeLog
+		}
+	}
+	if p.logInterval <= 0 {
+		p.logInterval = DefaultLogInterval
+	}
+	if p.logStates == nil {
+		p.logStates = make(map[*Tracker]trackerLogState)
+	}
+
+	// parse the layout template, if any; an invalid one is ignored
+	p.layout = nil
+	if p.style.Options.Layout != "" {
+		p.layout, _ = template.New("layout").Funcs(p.layoutFuncs()).Parse(p.style.Options.Layout)
+	}
 }
 
 // renderHint has hints for the Render*() logic
 type renderHint struct {
-	hideTime         bool // hide the time
-	hideValue        bool // hide the value
-	isOverallTracker bool // is the Overall Progress tracker
+	hideTime         bool   // hide the time
+	hideValue        bool   // hide the value
+	isOverallTracker bool   // is the Overall Progress tracker
+	lengthProgress   int    // length of the progress bar (to fit the terminal)
+	messageWidth     int    // width of the message (to fit the terminal)
+	prefix           string // connectors to render before a child tracker
+	showSpeed        bool   // show the speed
 }
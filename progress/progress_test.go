@@ -1,11 +1,16 @@
 package progress
 
 import (
+	"errors"
+	"io/ioutil"
 	"math"
 	"os"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/jedib0t/go-pretty/v6/text"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -26,6 +31,28 @@ func TestProgress_AppendTracker(t *testing.T) {
 	assert.Equal(t, int64(math.MaxInt64), tracker2.Total)
 }
 
+func TestProgress_AppendTracker_Concurrency(t *testing.T) {
+	p := Progress{}
+	tracker := &Tracker{Total: 1000}
+
+	// the Tracker may already be driven by another goroutine when appended
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for idx := 0; idx < 100; idx++ {
+			tracker.Increment(1)
+		}
+	}()
+	for idx := 0; idx < 10; idx++ {
+		p.AppendTracker(tracker)
+	}
+	wg.Wait()
+
+	assert.Equal(t, int64(100), tracker.Value())
+	assert.True(t, tracker.Speed() >= 0)
+}
+
 func TestProgress_AppendTrackers(t *testing.T) {
 	p := Progress{}
 	assert.Equal(t, 0, len(p.trackersInQueue))
@@ -77,6 +104,19 @@ func TestProgress_LengthDone(t *testing.T) {
 	assert.Equal(t, 1, p.LengthDone())
 }
 
+func TestProgress_LengthErrored(t *testing.T) {
+	p := Progress{}
+	assert.Equal(t, 0, p.LengthErrored())
+
+	trackerErrored := &Tracker{}
+	trackerErrored.MarkAsErrored(errors.New("connection reset"))
+	trackerCancelled := &Tracker{}
+	trackerCancelled.MarkAsCancelled()
+	p.trackersDone = append(p.trackersDone, &Tracker{}, trackerErrored, trackerCancelled)
+	assert.Equal(t, 3, p.LengthDone())
+	assert.Equal(t, 1, p.LengthErrored())
+}
+
 func TestProgress_LengthInQueue(t *testing.T) {
 	p := Progress{}
 	assert.Equal(t, 0, p.Length())
@@ -95,6 +135,76 @@ func TestProgress_SetAutoStop(t *testing.T) {
 	assert.True(t, p.autoStop)
 }
 
+func TestProgress_SetColorProfile(t *testing.T) {
+	p := Progress{}
+	p.Style().Colors.Percent = text.Colors{text.FgRGB(255, 0, 0)}
+	p.Style().Options.PercentFormat = "%.0f%%"
+	tracker := &Tracker{Total: 100}
+	tracker.SetValue(50)
+	assert.Nil(t, p.colorProfile)
+	assert.Equal(t, text.GetColorProfile(), p.getColorProfile())
+
+	for profile, expected := range map[text.ColorProfile]string{
+		text.ColorProfileNone:      "50%",
+		text.ColorProfileANSI:      "\x1b[91m50%\x1b[0m",
+		text.ColorProfileANSI256:   "\x1b[38;5;196m50%\x1b[0m",
+		text.ColorProfileTrueColor: "\x1b[38;2;255;0;0m50%\x1b[0m",
+	} {
+		p.SetColorProfile(profile)
+		assert.Equal(t, profile, p.getColorProfile())
+
+		var out strings.Builder
+		p.renderTrackerPercentage(&out, tracker)
+		assert.Equal(t, expected, out.String(), profile.String())
+	}
+}
+
+func TestProgress_SetColorProfile_Concurrency(t *testing.T) {
+	defer text.EnableColors()
+
+	newProgress := func(profile text.ColorProfile) *Progress {
+		p := &Progress{}
+		p.SetColorProfile(profile)
+		p.Style().Colors.Percent = text.Colors{text.FgRGB(255, 0, 0)}
+		p.Style().Options.PercentFormat = "%.0f%%"
+		return p
+	}
+	tracker := &Tracker{Total: 100}
+	tracker.SetValue(50)
+
+	var wg sync.WaitGroup
+	wg.Add(3)
+	go func() {
+		defer wg.Done()
+		for idx := 0; idx < 50; idx++ {
+			if idx%2 == 0 {
+				text.DisableColors()
+			} else {
+				text.EnableColors()
+			}
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		p := newProgress(text.ColorProfileANSI256)
+		for idx := 0; idx < 50; idx++ {
+			var out strings.Builder
+			p.renderTrackerPercentage(&out, tracker)
+			assert.Equal(t, "\x1b[38;5;196m50%\x1b[0m", out.String())
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		p := newProgress(text.ColorProfileNone)
+		for idx := 0; idx < 50; idx++ {
+			var out strings.Builder
+			p.renderTrackerPercentage(&out, tracker)
+			assert.Equal(t, "50%", out.String())
+		}
+	}()
+	wg.Wait()
+}
+
 func TestProgress_SetNumTrackersExpected(t *testing.T) {
 	p := Progress{}
 	assert.Equal(t, int64(0), p.numTrackersExpected)
@@ -111,6 +221,47 @@ func TestProgress_SetOutputWriter(t *testing.T) {
 	assert.Equal(t, os.Stdout, p.outputWriter)
 }
 
+func TestProgress_SetLogInterval(t *testing.T) {
+	p := Progress{}
+	assert.Equal(t, time.Duration(0), p.logInterval)
+
+	p.initForRender()
+	assert.Equal(t, DefaultLogInterval, p.logInterval)
+
+	p.SetLogInterval(time.Second)
+	assert.Equal(t, time.Second, p.logInterval)
+}
+
+func TestProgress_SetRenderMode(t *testing.T) {
+	p := Progress{}
+	assert.Equal(t, RenderModeAuto, p.renderMode)
+
+	p.SetOutputWriter(&strings.Builder{})
+	p.initForRender()
+	assert.Equal(t, RenderModeTerminal, p.renderModeInUse)
+
+	file, err := ioutil.TempFile("", "go-pretty-progress-")
+	if assert.Nil(t, err) {
+		defer os.Remove(file.Name())
+		defer file.Close()
+
+		p.SetOutputWriter(file)
+		p.initForRender()
+		assert.Equal(t, RenderModeLog, p.renderModeInUse)
+
+		p.SetRenderMode(RenderModeTerminal)
+		assert.Equal(t, RenderModeTerminal, p.renderMode)
+		p.initForRender()
+		assert.Equal(t, RenderModeTerminal, p.renderModeInUse)
+	}
+
+	p.SetOutputWriter(&strings.Builder{})
+	p.SetRenderMode(RenderModeLog)
+	assert.Equal(t, RenderModeLog, p.renderMode)
+	p.initForRender()
+	assert.Equal(t, RenderModeLog, p.renderModeInUse)
+}
+
 func TestProgress_SetSortBy(t *testing.T) {
 	p := Progress{}
 	assert.Zero(t, p.sortBy)
@@ -157,6 +308,14 @@ func TestProgress_SetUpdateFrequency(t *testing.T) {
 	assert.Equal(t, time.Duration(time.Second), p.updateFrequency)
 }
 
+func TestProgress_ShowDoneChildren(t *testing.T) {
+	p := Progress{}
+	assert.False(t, p.hideDoneChildren)
+
+	p.ShowDoneChildren(false)
+	assert.True(t, p.hideDoneChildren)
+}
+
 func TestProgress_ShowOverallTracker(t *testing.T) {
 	p := Progress{}
 	assert.False(t, p.showOverallTracker)
@@ -173,6 +332,14 @@ func TestProgress_ShowPercentage(t *testing.T) {
 	assert.True(t, p.hidePercentage)
 }
 
+func TestProgress_ShowSpeed(t *testing.T) {
+	p := Progress{}
+	assert.False(t, p.showSpeed)
+
+	p.ShowSpeed(true)
+	assert.True(t, p.showSpeed)
+}
+
 func TestProgress_ShowTime(t *testing.T) {
 	p := Progress{}
 	assert.False(t, p.hideTime)
@@ -3,6 +3,8 @@ package progress
 import (
 	"fmt"
 	"math"
+	"os"
+	"os/signal"
 	"strings"
 	"time"
 
@@ -17,6 +19,12 @@ func (p *Progress) Render() {
 
 		c := time.Tick(p.updateFrequency)
 		lastRenderLength := 0
+		resized := make(chan os.Signal, 1)
+		if p.renderModeInUse == RenderModeTerminal {
+			// re-render right away when the terminal gets resized
+			notifyOnResize(resized)
+			defer signal.Stop(resized)
+		}
 		p.renderInProgressMutex.Lock()
 		p.renderInProgress = true
 		p.renderInProgressMutex.Unlock()
@@ -26,7 +34,17 @@ func (p *Progress) Render() {
 				if p.LengthActive() > 0 {
 					lastRenderLength = p.renderTrackers(lastRenderLength)
 				}
+			case <-resized:
+				if p.LengthActive() > 0 {
+					lastRenderLength = p.renderTrackers(lastRenderLength)
+				}
 			case <-p.done:
+				switch p.renderModeInUse {
+				case RenderModeJSON:
+					p.renderJSONFinal()
+				case RenderModeLog:
+					p.renderLogSummary()
+				}
 				p.renderInProgressMutex.Lock()
 				p.renderInProgress = false
 				p.renderInProgressMutex.Unlock()
@@ -40,8 +58,14 @@ func (p *Progress) renderTrackers(lastRenderLength int) int {
 	var out strings.Builder
 	out.Grow(lastRenderLength)
 
+	// fit the trackers to the width of the terminal, which may have changed
+	// since the last render
+	if p.renderModeInUse == RenderModeTerminal {
+		p.terminalWidth = p.terminalWidthProvider()
+	}
+
 	// move up N times based on the number of active trackers
-	if lastRenderLength > 0 {
+	if lastRenderLength > 0 && p.renderModeInUse == RenderModeTerminal {
 		p.moveCursorToTheTop(&out)
 	}
 
@@ -50,25 +74,43 @@ func (p *Progress) renderTrackers(lastRenderLength int) int {
 
 	// sort and render the done trackers
 	for _, tracker := range trackersDone {
-		p.renderTracker(&out, tracker, renderHint{})
+		switch p.renderModeInUse {
+		case RenderModeJSON:
+			p.renderTrackerTreeJSON(&out, tracker)
+		case RenderModeLog:
+			p.renderTrackerTreeLog(&out, tracker, "")
+		default:
+			p.renderTrackerTree(&out, tracker, "", renderHint{})
+		}
 	}
 	p.trackersDoneMutex.Lock()
 	p.trackersDone = append(p.trackersDone, trackersDone...)
 	p.trackersDoneMutex.Unlock()
 
 	// sort and render the active trackers
+	outActiveStart := out.Len()
 	for _, tracker := range trackersActive {
-		p.renderTracker(&out, tracker, renderHint{})
+		switch p.renderModeInUse {
+		case RenderModeJSON:
+			p.renderTrackerTreeJSON(&out, tracker)
+		case RenderModeLog:
+			p.renderTrackerTreeLog(&out, tracker, "")
+		default:
+			p.renderTrackerTree(&out, tracker, "", renderHint{})
+		}
 	}
 	p.trackersActiveMutex.Lock()
 	p.trackersActive = trackersActive
 	p.trackersActiveMutex.Unlock()
 
 	// render the overall tracker
-	if p.showOverallTracker {
+	if p.showOverallTracker && p.renderModeInUse == RenderModeTerminal {
 		p.renderTracker(&out, p.overallTracker, renderHint{isOverallTracker: true})
 	}
 
+	// remember the lines rendered for the active trackers (to be redrawn)
+	p.linesActive = lineWidths(out.String()[outActiveStart:])
+
 	// write the text to the output writer
 	_, _ = p.outputWriter.Write([]byte(out.String()))
 
@@ -115,12 +157,26 @@ func (p *Progress) extractDoneAndActiveTrackers() ([]*Tracker, []*Tracker) {
 	p.overallTracker.value = int64(p.LengthDone()+len(trackersDone)) * 100
 	p.overallTracker.value += activeTrackersProgress
 	if len(trackersActive) == 0 {
-		p.overallTracker.MarkAsDone()
+		// the overall tracker fails if any of the trackers failed
+		numErrored := p.LengthErrored()
+		for _, tracker := range trackersDone {
+			if tracker.IsErrored() {
+				numErrored++
+			}
+		}
+		if numErrored > 0 {
+			p.overallTracker.MarkAsErrored(fmt.Errorf("%d of %d trackers failed", numErrored, p.Length()))
+		} else {
+			p.overallTracker.MarkAsDone()
+		}
 	}
 	return trackersActive, trackersDone
 }
 
 func (p *Progress) generateTrackerStr(t *Tracker, maxLen int, hint renderHint) string {
+	if !hint.isOverallTracker && len(t.Spinner) > 0 {
+		return p.generateTrackerStrSpinner(t)
+	}
 	if !hint.isOverallTracker && (t.Total == 0 || t.value > t.Total) {
 		return p.generateTrackerStrIndeterminate(t, maxLen)
 	}
@@ -159,7 +215,7 @@ func (p *Progress) generateTrackerStrDeterminate(t *Tracker, maxLen int) string
 		pUnfinished = strings.Repeat(p.style.Chars.Unfinished, maxLen-pFinishedStrLen)
 	}
 
-	return p.style.Colors.Tracker.Sprintf("%s%s%s%s%s",
+	return p.style.Colors.Tracker.SprintProfile(p.getColorProfile(),
 		p.style.Chars.BoxLeft, pFinished, pInProgress, pUnfinished, p.style.Chars.BoxRight,
 	)
 }
@@ -178,15 +234,31 @@ func (p *Progress) generateTrackerStrIndeterminate(t *Tracker, maxLen int) strin
 		pUnfinished += strings.Repeat(p.style.Chars.Unfinished, maxLen-text.RuneCount(pUnfinished))
 	}
 
-	return p.style.Colors.Tracker.Sprintf("%s%s%s",
+	return p.style.Colors.Tracker.SprintProfile(p.getColorProfile(),
 		p.style.Chars.BoxLeft, string(pUnfinished), p.style.Chars.BoxRight,
 	)
 }
 
+// generateTrackerStrSpinner generates the tracker string for a Tracker with a
+// Spinner, which moves to the next frame every render cycle.
+func (p *Progress) generateTrackerStrSpinner(t *Tracker) string {
+	t.mutexPrv.RLock()
+	timeElapsed := time.Since(t.timeStart)
+	t.mutexPrv.RUnlock()
+	frame := t.Spinner[int(timeElapsed/p.updateFrequency)%len(t.Spinner)]
+
+	return p.style.Colors.Tracker.SprintProfile(p.getColorProfile(), frame)
+}
+
 func (p *Progress) moveCursorToTheTop(out *strings.Builder) {
-	numLinesToMoveUp := len(p.trackersActive)
-	if p.showOverallTracker && p.overallTracker != nil && !p.overallTracker.IsDone() {
+	// a line wider than the terminal takes up more than one line on it, which
+	// happens to the lines rendered before the terminal got narrower
+	numLinesToMoveUp := 0
+	for _, lineWidth := range p.linesActive {
 		numLinesToMoveUp++
+		if p.terminalWidth > 0 && lineWidth > p.terminalWidth {
+			numLinesToMoveUp += (lineWidth - 1) / p.terminalWidth
+		}
 	}
 	if numLinesToMoveUp > 0 {
 		out.WriteString(text.CursorUp.Sprintn(numLinesToMoveUp))
@@ -194,76 +266,176 @@ func (p *Progress) moveCursorToTheTop(out *strings.Builder) {
 }
 
 func (p *Progress) renderTracker(out *strings.Builder, t *Tracker, hint renderHint) {
-	if strings.Contains(t.Message, "\t") {
-		t.Message = strings.Replace(t.Message, "\t", "    ", -1)
-	}
-	if strings.Contains(t.Message, "\r") {
-		t.Message = strings.Replace(t.Message, "\r", "", -1)
+	message := p.sanitizeMessage(t.message())
+	hint.lengthProgress, hint.messageWidth = p.lengthProgress, p.messageWidth
+
+	line := p.generateTrackerLine(t, message, hint)
+	if p.terminalWidth > 0 && text.RuneCount(line) > p.terminalWidth {
+		// shrink the progress bar, and then the message, to fit the terminal
+		if excess := text.RuneCount(line) - p.terminalWidth; hint.lengthProgress > 1 {
+			hint.lengthProgress = maxInt(hint.lengthProgress-excess, 1)
+			line = p.generateTrackerLine(t, message, hint)
+		}
+		if excess := text.RuneCount(line) - p.terminalWidth; excess > 0 {
+			messageLen := text.RuneCount(p.fitMessage(message, hint))
+			hint.messageWidth = text.RuneCount(hint.prefix) + maxInt(messageLen-excess, 1)
+			line = p.generateTrackerLine(t, message, hint)
+		}
+		if text.RuneCount(line) > p.terminalWidth {
+			line = text.Trim(line, p.terminalWidth)
+		}
 	}
 
 	out.WriteString(text.EraseLine.Sprint())
+	if line != "" {
+		out.WriteString(line)
+		out.WriteRune('\n')
+	}
+}
+
+// generateTrackerLine generates the line (without the trailing newline) for
+// the tracker, prefixed with the connectors before children.
+func (p *Progress) generateTrackerLine(t *Tracker, message string, hint renderHint) string {
+	var out strings.Builder
+	if hint.prefix != "" {
+		out.WriteString(p.style.Colors.Message.SprintProfile(p.getColorProfile(), hint.prefix))
+	}
 	if hint.isOverallTracker {
 		if !t.IsDone() {
-			trackerLen := p.messageWidth
+			trackerLen := hint.messageWidth
 			trackerLen += text.RuneCount(p.style.Options.Separator)
 			trackerLen += text.RuneCount(p.style.Options.DoneString)
-			trackerLen += p.lengthProgress + 1
+			trackerLen += hint.lengthProgress + 1
 			hint := renderHint{hideValue: true, isOverallTracker: true}
-			p.renderTrackerProgress(out, t, p.generateTrackerStr(t, trackerLen, hint), hint)
+			p.renderTrackerProgress(&out, t, message, p.generateTrackerStr(t, trackerLen, hint), hint)
 		}
-	} else {
+	} else if p.layout == nil || !p.renderTrackerLayout(&out, t, message, hint) {
 		if t.IsDone() {
-			p.renderTrackerDone(out, t)
+			p.renderTrackerDone(&out, t, message, hint)
 		} else {
-			hint := renderHint{hideTime: p.hideTime, hideValue: p.hideValue}
-			p.renderTrackerProgress(out, t, p.generateTrackerStr(t, p.lengthProgress, hint), hint)
+			hint := renderHint{
+				hideTime:       p.hideTime,
+				hideValue:      p.hideValue,
+				lengthProgress: hint.lengthProgress,
+				messageWidth:   hint.messageWidth,
+				prefix:         hint.prefix,
+				showSpeed:      p.showSpeed,
+			}
+			p.renderTrackerProgress(&out, t, message, p.generateTrackerStr(t, hint.lengthProgress, hint), hint)
 		}
 	}
+	return strings.TrimSuffix(out.String(), "\n")
 }
 
-func (p *Progress) renderTrackerDone(out *strings.Builder, t *Tracker) {
-	out.WriteString(p.style.Colors.Message.Sprint(t.Message))
-	out.WriteString(p.style.Colors.Message.Sprint(p.style.Options.Separator))
-	out.WriteString(p.style.Colors.Message.Sprint(p.style.Options.DoneString))
-	p.renderTrackerStats(out, t, renderHint{hideTime: p.hideTime, hideValue: p.hideValue})
+// renderTrackerSubMessage renders the sub-message of the tracker (if any) on
+// a line of its own.
+func (p *Progress) renderTrackerSubMessage(out *strings.Builder, t *Tracker, indent string) {
+	subMessage := p.sanitizeMessage(t.SubMessage())
+	if subMessage == "" || t.IsDone() {
+		return
+	}
+	if messageWidth := p.messageWidth - text.RuneCount(indent); messageWidth > 0 {
+		subMessage = text.Snip(subMessage, messageWidth, p.style.Options.SnipIndicator)
+	}
+	if maxWidth := p.terminalWidth - text.RuneCount(indent); p.terminalWidth > 0 {
+		subMessage = text.Snip(subMessage, maxInt(maxWidth, 1), p.style.Options.SnipIndicator)
+	}
+
+	out.WriteString(text.EraseLine.Sprint())
+	line := p.style.Colors.Message.SprintProfile(p.getColorProfile(), indent) +
+		p.style.Colors.SubMessage.SprintProfile(p.getColorProfile(), subMessage)
+	if p.terminalWidth > 0 && text.RuneCount(line) > p.terminalWidth {
+		line = text.Trim(line, p.terminalWidth)
+	}
+	out.WriteString(line)
 	out.WriteRune('\n')
 }
 
-func (p *Progress) renderTrackerProgress(out *strings.Builder, t *Tracker, trackerStr string, hint renderHint) {
-	if p.messageWidth > 0 {
-		messageLen := text.RuneCount(t.Message)
-		if messageLen < p.messageWidth {
-			t.Message = text.Pad(t.Message, p.messageWidth, ' ')
-		} else {
-			t.Message = text.Snip(t.Message, p.messageWidth, p.style.Options.SnipIndicator)
+// renderTrackerTree renders the tracker followed by its sub-message and its
+// children (indented using the connectors in Style.Tree).
+func (p *Progress) renderTrackerTree(out *strings.Builder, t *Tracker, indent string, hint renderHint) {
+	p.renderTracker(out, t, hint)
+
+	var children []*Tracker
+	for _, child := range t.Children() {
+		if !p.hideDoneChildren || !child.IsDone() {
+			children = append(children, child)
+		}
+	}
+	if len(children) > 0 {
+		p.renderTrackerSubMessage(out, t, indent+p.style.Tree.CharItemVertical)
+	} else {
+		p.renderTrackerSubMessage(out, t, indent+strings.Repeat(" ", text.RuneCount(p.style.Tree.CharItemVertical)))
+	}
+	for idx, child := range children {
+		connector, vertical := p.style.Tree.CharItemMiddle, p.style.Tree.CharItemVertical
+		if idx == len(children)-1 {
+			connector = p.style.Tree.CharItemBottom
+			vertical = strings.Repeat(" ", text.RuneCount(vertical))
+		} else if idx == 0 {
+			connector = p.style.Tree.CharItemFirst
 		}
+		p.renderTrackerTree(out, child, indent+vertical, renderHint{prefix: indent + connector + " "})
 	}
+}
+
+func (p *Progress) renderTrackerDone(out *strings.Builder, t *Tracker, message string, hint renderHint) {
+	message = p.fitMessage(message, hint)
+	out.WriteString(p.style.Colors.Message.SprintProfile(p.getColorProfile(), message))
+	out.WriteString(p.style.Colors.Message.SprintProfile(p.getColorProfile(), p.style.Options.Separator))
+	if t.IsErrored() {
+		out.WriteString(p.style.Colors.Error.SprintProfile(p.getColorProfile(), p.style.Options.ErrorString))
+	} else if t.IsCancelled() {
+		out.WriteString(p.style.Colors.Message.SprintProfile(p.getColorProfile(), p.style.Options.CancelledString))
+	} else {
+		out.WriteString(p.style.Colors.Message.SprintProfile(p.getColorProfile(), p.style.Options.DoneString))
+	}
+	p.renderTrackerStats(out, t, renderHint{hideTime: p.hideTime, hideValue: p.hideValue, showSpeed: p.showSpeed})
+	if err := t.Err(); err != nil {
+		// keep the error message on the same line as the tracker
+		errMsg := strings.Join(strings.Fields(err.Error()), " ")
+		out.WriteString(p.style.Colors.Error.SprintProfile(p.getColorProfile(), ": "+errMsg))
+	}
+	out.WriteRune('\n')
+}
+
+func (p *Progress) renderTrackerProgress(out *strings.Builder, t *Tracker, message string, trackerStr string, hint renderHint) {
+	message = p.fitMessage(message, hint)
 
 	if hint.isOverallTracker {
-		out.WriteString(p.style.Colors.Tracker.Sprint(trackerStr))
+		out.WriteString(p.style.Colors.Tracker.SprintProfile(p.getColorProfile(), trackerStr))
 		p.renderTrackerStats(out, t, hint)
 		out.WriteRune('\n')
 	} else if p.trackerPosition == PositionRight {
-		out.WriteString(p.style.Colors.Message.Sprint(t.Message))
-		out.WriteString(p.style.Colors.Message.Sprint(p.style.Options.Separator))
-		p.renderTrackerPercentage(out, t)
-		if !p.hideTracker {
-			out.WriteString(p.style.Colors.Tracker.Sprint(" " + trackerStr))
-		}
+		out.WriteString(p.style.Colors.Message.SprintProfile(p.getColorProfile(), message))
+		out.WriteString(p.style.Colors.Message.SprintProfile(p.getColorProfile(), p.style.Options.Separator))
+		p.renderTrackerIndicator(out, t, trackerStr)
 		p.renderTrackerStats(out, t, hint)
 		out.WriteRune('\n')
 	} else {
-		p.renderTrackerPercentage(out, t)
-		if !p.hideTracker {
-			out.WriteString(p.style.Colors.Tracker.Sprint(" " + trackerStr))
-		}
+		p.renderTrackerIndicator(out, t, trackerStr)
 		p.renderTrackerStats(out, t, hint)
-		out.WriteString(p.style.Colors.Message.Sprint(p.style.Options.Separator))
-		out.WriteString(p.style.Colors.Message.Sprint(t.Message))
+		out.WriteString(p.style.Colors.Message.SprintProfile(p.getColorProfile(), p.style.Options.Separator))
+		out.WriteString(p.style.Colors.Message.SprintProfile(p.getColorProfile(), message))
 		out.WriteRune('\n')
 	}
 }
 
+// renderTrackerIndicator renders the percentage followed by the progress bar,
+// or just the spinner for a Tracker with a Spinner.
+func (p *Progress) renderTrackerIndicator(out *strings.Builder, t *Tracker, trackerStr string) {
+	if len(t.Spinner) > 0 {
+		if !p.hideTracker {
+			out.WriteString(p.style.Colors.Tracker.SprintProfile(p.getColorProfile(), trackerStr))
+		}
+		return
+	}
+	p.renderTrackerPercentage(out, t)
+	if !p.hideTracker {
+		out.WriteString(p.style.Colors.Tracker.SprintProfile(p.getColorProfile(), " "+trackerStr))
+	}
+}
+
 func (p *Progress) renderTrackerPercentage(out *strings.Builder, t *Tracker) {
 	if !p.hidePercentage {
 		var percentageStr string
@@ -272,17 +444,21 @@ func (p *Progress) renderTrackerPercentage(out *strings.Builder, t *Tracker) {
 		} else {
 			percentageStr = fmt.Sprintf(p.style.Options.PercentFormat, t.PercentDone())
 		}
-		out.WriteString(p.style.Colors.Percent.Sprint(percentageStr))
+		out.WriteString(p.style.Colors.Percent.SprintProfile(p.getColorProfile(), percentageStr))
 	}
 }
 
 func (p *Progress) renderTrackerStats(out *strings.Builder, t *Tracker, hint renderHint) {
-	if !hint.hideValue || !hint.hideTime {
+	var metadata []string
+	if !hint.isOverallTracker {
+		metadata = t.metadataPairs()
+	}
+	if !hint.hideValue || !hint.hideTime || hint.showSpeed || len(metadata) > 0 {
 		var outStats strings.Builder
 		outStats.WriteString(" [")
 		if !hint.hideValue {
 			t.mutex.Lock()
-			outStats.WriteString(p.style.Colors.Value.Sprint(t.Units.Sprint(t.value)))
+			outStats.WriteString(p.style.Colors.Value.SprintProfile(p.getColorProfile(), t.Units.Sprint(t.value)))
 			t.mutex.Unlock()
 		}
 		if !hint.hideValue && !hint.hideTime {
@@ -302,14 +478,26 @@ func (p *Progress) renderTrackerStats(out *strings.Builder, t *Tracker, hint ren
 			} else {
 				tp = p.style.Options.TimeInProgressPrecision
 			}
-			outStats.WriteString(p.style.Colors.Time.Sprint(td.Round(tp)))
-			if p.showETA || hint.isOverallTracker {
-				p.renderTrackerStatsETA(&outStats, t, hint)
+			outStats.WriteString(p.style.Colors.Time.SprintProfile(p.getColorProfile(), td.Round(tp)))
+		}
+		if hint.showSpeed {
+			if !hint.hideValue || !hint.hideTime {
+				outStats.WriteString(" @ ")
 			}
+			p.renderTrackerStatsSpeed(&outStats, t)
+		}
+		if !hint.hideTime && (p.showETA || hint.isOverallTracker) {
+			p.renderTrackerStatsETA(&outStats, t, hint)
+		}
+		if len(metadata) > 0 {
+			if !hint.hideValue || !hint.hideTime || hint.showSpeed {
+				outStats.WriteString("; ")
+			}
+			outStats.WriteString(strings.Join(metadata, " "))
 		}
 		outStats.WriteRune(']')
 
-		out.WriteString(p.style.Colors.Stats.Sprint(outStats.String()))
+		out.WriteString(p.style.Colors.Stats.SprintProfile(p.getColorProfile(), outStats.String()))
 	}
 }
 
@@ -319,6 +507,55 @@ func (p *Progress) renderTrackerStatsETA(out *strings.Builder, t *Tracker, hint
 		out.WriteString("; ")
 		out.WriteString(p.style.Options.ETAString)
 		out.WriteString(": ")
-		out.WriteString(p.style.Colors.Time.Sprint(eta))
+		out.WriteString(p.style.Colors.Time.SprintProfile(p.getColorProfile(), eta))
+	}
+}
+
+func (p *Progress) renderTrackerStatsSpeed(out *strings.Builder, t *Tracker) {
+	speed := t.Speed()
+	t.mutex.RLock()
+	speedStr := t.Units.Sprint(int64(math.Round(speed))) + p.style.Options.SpeedSuffix
+	t.mutex.RUnlock()
+	out.WriteString(p.style.Colors.Speed.SprintProfile(p.getColorProfile(), speedStr))
+}
+
+// fitMessage pads or snips the message to the message width (if set), while
+// discounting the connectors before children to keep the trackers aligned.
+func (p *Progress) fitMessage(message string, hint renderHint) string {
+	if messageWidth := hint.messageWidth - text.RuneCount(hint.prefix); messageWidth > 0 {
+		messageLen := text.RuneCount(message)
+		if messageLen < messageWidth {
+			message = text.Pad(message, messageWidth, ' ')
+		} else {
+			message = text.Snip(message, messageWidth, p.style.Options.SnipIndicator)
+		}
+	}
+	return message
+}
+
+// lineWidths returns the widths of the lines (ignoring the escape sequences)
+// in the rendered output.
+func lineWidths(out string) []int {
+	lines := strings.Split(strings.Replace(out, text.EraseLine.Sprint(), "", -1), "\n")
+	widths := make([]int, 0, len(lines)-1)
+	for _, line := range lines[:len(lines)-1] {
+		widths = append(widths, text.RuneCount(line))
+	}
+	return widths
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// sanitizeMessage makes the (sub-)message of a tracker fit on a single line,
+// and converts the colors in it to the color profile in use.
+func (p *Progress) sanitizeMessage(message string) string {
+	if strings.ContainsAny(message, "\t\r\n") {
+		message = strings.NewReplacer("\t", "    ", "\r", "", "\n", " ").Replace(message)
 	}
+	return p.getColorProfile().Convert(message)
 }
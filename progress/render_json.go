@@ -0,0 +1,146 @@
+package progress
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/text"
+)
+
+// TrackerEvent is the event emitted for a change in the state of a Tracker in
+// RenderModeJSON, as a single line of JSON. For ex.:
+//  {"event":"updated","id":1,"message":"Downloading foo","value":1260000,"total":3000000,"percent":42,"eta":2.5,"time":"2021-05-02T15:04:05.5Z","time_start":"2021-05-02T15:04:04Z"}
+type TrackerEvent struct {
+	// Event is one of "added", "updated", "done", "errored" and "cancelled"
+	Event string `json:"event"`
+	// ID identifies the Tracker across the events (in the order of addition)
+	ID int `json:"id"`
+	// ParentID is the ID of the parent of a child Tracker
+	ParentID int `json:"parent_id,omitempty"`
+	// Message is the Message of the Tracker without any escape sequences
+	Message string `json:"message"`
+	// SubMessage is the sub-message of the Tracker without any escape sequences
+	SubMessage string `json:"sub_message,omitempty"`
+	// Metadata has the key/value pairs set using Tracker.SetMetadata; values
+	// that cannot be encoded as JSON (like NaN) are in their string forms
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	// Value is the current value of the Tracker
+	Value int64 `json:"value"`
+	// Total is the Total of the Tracker (0 when indeterminate)
+	Total int64 `json:"total"`
+	// Percent is the percentage done
+	Percent float64 `json:"percent"`
+	// ETA is the estimated time left in seconds (0 when unknown or done)
+	ETA float64 `json:"eta"`
+	// Error is the error the Tracker was marked as errored with
+	Error string `json:"error,omitempty"`
+	// Time is the time of the event
+	Time time.Time `json:"time"`
+	// TimeStart is the time the Tracker was started
+	TimeStart time.Time `json:"time_start"`
+	// TimeStop is the time the Tracker was done (errored or cancelled)
+	TimeStop *time.Time `json:"time_stop,omitempty"`
+}
+
+// renderJSONFinal emits the events for the Trackers that changed since the
+// last render.
+func (p *Progress) renderJSONFinal() {
+	var out strings.Builder
+	for _, tracker := range p.getAllTrackers() {
+		p.renderTrackerTreeJSON(&out, tracker)
+	}
+	if out.Len() > 0 {
+		_, _ = p.outputWriter.Write([]byte(out.String()))
+	}
+}
+
+// renderTrackerJSON emits the events for the Tracker if it was added, made
+// progress or ended since the last render, and returns the ID of the Tracker.
+func (p *Progress) renderTrackerJSON(out *strings.Builder, t *Tracker, parentID int) int {
+	now, isDone, subMessage, value := time.Now(), t.IsDone(), t.SubMessage(), t.Value()
+	state, seen := p.logStates[t]
+	if seen && (state.done || (!isDone && value == state.value && subMessage == state.subMessage)) {
+		return state.id
+	}
+	if !seen {
+		state.id = len(p.logStates) + 1
+		p.renderTrackerJSONEvent(out, t, "added", state.id, parentID, now)
+	}
+	p.logStates[t] = trackerLogState{done: isDone, id: state.id, subMessage: subMessage, time: now, value: value}
+
+	switch {
+	case t.IsErrored():
+		p.renderTrackerJSONEvent(out, t, "errored", state.id, parentID, now)
+	case t.IsCancelled():
+		p.renderTrackerJSONEvent(out, t, "cancelled", state.id, parentID, now)
+	case isDone:
+		p.renderTrackerJSONEvent(out, t, "done", state.id, parentID, now)
+	case seen:
+		p.renderTrackerJSONEvent(out, t, "updated", state.id, parentID, now)
+	}
+	return state.id
+}
+
+func (p *Progress) renderTrackerJSONEvent(out *strings.Builder, t *Tracker, event string, id int, parentID int, now time.Time) {
+	e := TrackerEvent{
+		Event:      event,
+		ID:         id,
+		ParentID:   parentID,
+		Message:    strings.TrimSpace(text.StripEscape(t.message())),
+		SubMessage: strings.TrimSpace(text.StripEscape(t.SubMessage())),
+		Percent:    t.PercentDone(),
+		Time:       now,
+	}
+	if !t.IsDone() {
+		e.ETA = t.ETA().Seconds()
+	}
+	if err := t.Err(); err != nil {
+		e.Error = err.Error()
+	}
+	if metadata := t.Metadata(); len(metadata) > 0 {
+		e.Metadata = jsonMetadata(metadata)
+	}
+	t.mutex.RLock()
+	e.Value, e.Total = t.value, t.Total
+	t.mutex.RUnlock()
+	t.mutexPrv.RLock()
+	e.TimeStart = t.timeStart
+	if !t.timeStop.IsZero() {
+		timeStop := t.timeStop
+		e.TimeStop = &timeStop
+	}
+	t.mutexPrv.RUnlock()
+
+	data, err := json.Marshal(e)
+	if err != nil { // should never happen with the metadata made safe above
+		return
+	}
+	out.Write(data)
+	out.WriteRune('\n')
+}
+
+// renderTrackerTreeJSON emits the events for the Tracker and its children.
+func (p *Progress) renderTrackerTreeJSON(out *strings.Builder, t *Tracker) {
+	var renderTree func(t *Tracker, parentID int)
+	renderTree = func(t *Tracker, parentID int) {
+		id := p.renderTrackerJSON(out, t, parentID)
+		for _, child := range t.Children() {
+			renderTree(child, id)
+		}
+	}
+	renderTree(t, 0)
+}
+
+// jsonMetadata returns the metadata with the values that cannot be encoded as
+// JSON (like NaN, or channels) replaced with their string forms, so that they
+// do not keep the events from being emitted.
+func jsonMetadata(metadata map[string]interface{}) map[string]interface{} {
+	for key, value := range metadata {
+		if _, err := json.Marshal(value); err != nil {
+			metadata[key] = fmt.Sprint(value)
+		}
+	}
+	return metadata
+}
@@ -0,0 +1,165 @@
+package progress
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProgress_RenderJSON(t *testing.T) {
+	renderOutput := outputWriter{}
+
+	pw := generateWriter()
+	pw.SetOutputWriter(&renderOutput)
+	pw.SetRenderMode(RenderModeJSON)
+	pw.ShowOverallTracker(true)
+	trackerErrored := &Tracker{Message: "Downloading File    # 2", Total: 1000, Units: UnitsBytes}
+	trackerCancelled := &Tracker{Message: "Transferring Amount # 3", Total: 1000, Units: UnitsCurrencyDollar}
+	trackerParent := &Tracker{Message: "Deploying"}
+	trackerParent.AppendChild(&Tracker{Message: "\x1b[91mUploading\x1b[0m", Total: 10})
+	go trackSomething(pw, &Tracker{Message: "Downloading File    # 1", Total: 1000, Units: UnitsBytes})
+	go trackSomethingWithChildren(pw, trackerParent)
+	pw.AppendTrackers([]*Tracker{trackerErrored, trackerCancelled})
+	trackerErrored.Increment(250)
+	trackerErrored.MarkAsErrored(errors.New("connection\nreset"))
+	trackerCancelled.MarkAsCancelled()
+	renderAndWait(pw, false)
+	for pw.IsRenderInProgress() {
+		time.Sleep(time.Millisecond * 10)
+	}
+
+	out := renderOutput.String()
+	assert.NotContains(t, out, "\x1b")
+	eventsByMessage := make(map[string][]TrackerEvent)
+	for _, line := range strings.Split(strings.TrimSuffix(out, "\n"), "\n") {
+		var event TrackerEvent
+		if assert.Nil(t, json.Unmarshal([]byte(line), &event), line) {
+			assert.False(t, event.Time.IsZero())
+			assert.False(t, event.TimeStart.IsZero())
+			eventsByMessage[event.Message] = append(eventsByMessage[event.Message], event)
+		}
+	}
+	assert.Len(t, eventsByMessage, 5)
+
+	events := eventsByMessage["Downloading File    # 1"]
+	if assert.True(t, len(events) >= 3, events) {
+		assert.Equal(t, "added", events[0].Event)
+		assert.Nil(t, events[0].TimeStop)
+		for idx, event := range events[1 : len(events)-1] {
+			assert.Equal(t, "updated", event.Event)
+			assert.True(t, event.Value > events[idx].Value)
+			assert.True(t, event.Percent > 0 && event.Percent < 100)
+			assert.Equal(t, int64(1000), event.Total)
+		}
+		lastEvent := events[len(events)-1]
+		assert.Equal(t, "done", lastEvent.Event)
+		assert.Equal(t, int64(1000), lastEvent.Value)
+		assert.Equal(t, 100.0, lastEvent.Percent)
+		assert.Equal(t, 0.0, lastEvent.ETA)
+		assert.NotNil(t, lastEvent.TimeStop)
+	}
+
+	events = eventsByMessage["Downloading File    # 2"]
+	if assert.Len(t, events, 2) {
+		assert.Equal(t, "added", events[0].Event)
+		assert.Equal(t, "errored", events[1].Event)
+		assert.Equal(t, int64(250), events[1].Value)
+		assert.Equal(t, "connection\nreset", events[1].Error)
+	}
+	events = eventsByMessage["Transferring Amount # 3"]
+	if assert.Len(t, events, 2) {
+		assert.Equal(t, "cancelled", events[1].Event)
+	}
+
+	parentEvents, childEvents := eventsByMessage["Deploying"], eventsByMessage["Uploading"]
+	if assert.NotEmpty(t, parentEvents) && assert.NotEmpty(t, childEvents) {
+		assert.Equal(t, 0, parentEvents[0].ParentID)
+		assert.Equal(t, parentEvents[0].ID, childEvents[0].ParentID)
+		assert.NotEqual(t, parentEvents[0].ID, childEvents[0].ID)
+		assert.Equal(t, "done", parentEvents[len(parentEvents)-1].Event)
+		assert.Equal(t, "done", childEvents[len(childEvents)-1].Event)
+	}
+	showOutputOnFailure(t, out)
+}
+
+func TestProgress_RenderJSON_WithSubMessageAndMetadata(t *testing.T) {
+	renderOutput := outputWriter{}
+
+	pw := generateWriter()
+	pw.SetOutputWriter(&renderOutput)
+	pw.SetRenderMode(RenderModeJSON)
+	tracker := &Tracker{Message: "Downloading Files", Total: 1000, Units: UnitsBytes}
+	tracker.SetMetadata("host", "web-01")
+	tracker.SetMetadata("retries", 2)
+	pw.AppendTracker(tracker)
+	go pw.Render()
+	time.Sleep(time.Millisecond * 100)
+	tracker.UpdateSubMessage("foo.txt")
+	time.Sleep(time.Millisecond * 100)
+	tracker.MarkAsDone()
+	time.Sleep(time.Millisecond * 100)
+	pw.Stop()
+	for pw.IsRenderInProgress() {
+		time.Sleep(time.Millisecond * 10)
+	}
+
+	out := renderOutput.String()
+	var events []TrackerEvent
+	for _, line := range strings.Split(strings.TrimSuffix(out, "\n"), "\n") {
+		var event TrackerEvent
+		if assert.Nil(t, json.Unmarshal([]byte(line), &event), line) {
+			events = append(events, event)
+		}
+	}
+	if assert.Len(t, events, 3) {
+		// a change in the sub-message is an update even without progress
+		assert.Equal(t, "added", events[0].Event)
+		assert.Equal(t, "", events[0].SubMessage)
+		assert.Equal(t, "updated", events[1].Event)
+		assert.Equal(t, "foo.txt", events[1].SubMessage)
+		assert.Equal(t, int64(0), events[1].Value)
+		assert.Equal(t, "done", events[2].Event)
+		for _, event := range events {
+			assert.Equal(t, map[string]interface{}{"host": "web-01", "retries": 2.0}, event.Metadata)
+		}
+	}
+	showOutputOnFailure(t, out)
+}
+
+func TestProgress_RenderJSON_WithMetadataNotEncodable(t *testing.T) {
+	renderOutput := outputWriter{}
+
+	pw := generateWriter()
+	pw.SetOutputWriter(&renderOutput)
+	pw.SetRenderMode(RenderModeJSON)
+	p := pw.(*Progress)
+	tracker := &Tracker{Message: "Downloading Files", Total: 1000}
+	tracker.SetMetadata("host", "web-01")
+	tracker.SetMetadata("ratio", math.NaN())
+	tracker.SetMetadata("signal", make(chan bool))
+	pw.AppendTracker(tracker)
+	p.initForRender()
+	p.renderJSONFinal()
+	tracker.MarkAsDone()
+	p.renderJSONFinal()
+
+	out := renderOutput.String()
+	lines := strings.Split(strings.TrimSuffix(out, "\n"), "\n")
+	if assert.Len(t, lines, 2, out) {
+		for idx, expectedEvent := range []string{"added", "done"} {
+			var event TrackerEvent
+			if assert.Nil(t, json.Unmarshal([]byte(lines[idx]), &event), lines[idx]) {
+				assert.Equal(t, expectedEvent, event.Event)
+				assert.Equal(t, "web-01", event.Metadata["host"])
+				assert.Equal(t, "NaN", event.Metadata["ratio"])
+				assert.Regexp(t, "^0x[0-9a-f]+$", event.Metadata["signal"])
+			}
+		}
+	}
+	showOutputOnFailure(t, out)
+}
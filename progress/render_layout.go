@@ -0,0 +1,127 @@
+package progress
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/text"
+)
+
+// LayoutFields are the fields available to the template in
+// StyleOptions.Layout, which (when set) is used to render each Tracker line
+// instead of the built-in layout. For ex.:
+//  {{.Message}} {{.Bar}} {{.Percent}} {{.Value}}/{{.Total}} {{.Speed}} ETA {{.ETA}}
+//
+// All the fields are already colored using StyleColors, and the Layout
+// overrides the Tracker position and the Show*() options for the parts. The
+// widths are "escape sequence aware" and can be controlled in the template
+// using these functions:
+//  pad N s        pads s on the right with spaces to N characters
+//  padLeft N s    pads s on the left with spaces to N characters
+//  snip N s       snips s to N characters (using StyleOptions.SnipIndicator)
+// For ex.:
+//  {{.Message | snip 30 | pad 30}} {{.Bar}} {{.Value | padLeft 9}}
+//
+// An invalid template is ignored, and the built-in layout is used instead.
+// The overall tracker is always rendered using the built-in layout.
+type LayoutFields struct {
+	Bar      string // the progress bar (SetTrackerLength characters wide)
+	ETA      string // the ETA (empty if unknown or done)
+	Error    string // the error the Tracker was marked as errored with
+	Message  string // the message (padded or snipped to SetMessageWidth)
+	Metadata string // the metadata (see Tracker.SetMetadata) like "key=value"
+	Percent  string // the percentage done (see StyleOptions.PercentFormat)
+	Speed    string // the speed (see StyleOptions.SpeedSuffix)
+	Status   string // the done/error/cancelled string once done, else empty
+	Time     string // the time taken so far
+	Total    string // the total (empty if indeterminate)
+	Value    string // the value
+}
+
+// layoutFuncs returns the functions available to the template in
+// StyleOptions.Layout.
+func (p *Progress) layoutFuncs() template.FuncMap {
+	return template.FuncMap{
+		"pad": func(width int, str string) string {
+			return text.Pad(str, width, ' ')
+		},
+		"padLeft": func(width int, str string) string {
+			if strLen := text.RuneCount(str); strLen < width {
+				return strings.Repeat(" ", width-strLen) + str
+			}
+			return str
+		},
+		"snip": func(width int, str string) string {
+			return text.Snip(str, width, p.style.Options.SnipIndicator)
+		},
+	}
+}
+
+// generateLayoutFields generates the (colored) fields for rendering the
+// Tracker using the layout template.
+func (p *Progress) generateLayoutFields(t *Tracker, message string, hint renderHint) LayoutFields {
+	colorProfile, isDone := p.getColorProfile(), t.IsDone()
+	t.mutex.RLock()
+	total, units, value := t.Total, t.Units, t.value
+	t.mutex.RUnlock()
+
+	message = p.fitMessage(message, hint)
+
+	fields := LayoutFields{
+		Bar:      p.style.Colors.Tracker.SprintProfile(colorProfile, p.generateTrackerStr(t, hint.lengthProgress, hint)),
+		Message:  p.style.Colors.Message.SprintProfile(colorProfile, message),
+		Metadata: p.style.Colors.Stats.SprintProfile(colorProfile, strings.Join(t.metadataPairs(), " ")),
+		Value:    p.style.Colors.Value.SprintProfile(colorProfile, units.Sprint(value)),
+	}
+	percent := p.style.Options.PercentIndeterminate
+	if !t.IsIndeterminate() {
+		fields.Total = p.style.Colors.Value.SprintProfile(colorProfile, units.Sprint(total))
+		percent = fmt.Sprintf(p.style.Options.PercentFormat, t.PercentDone())
+	}
+	fields.Percent = p.style.Colors.Percent.SprintProfile(colorProfile, percent)
+	var speed strings.Builder
+	p.renderTrackerStatsSpeed(&speed, t)
+	fields.Speed = speed.String()
+
+	// time taken, and the ETA or the final status
+	t.mutexPrv.RLock()
+	timeTaken, timePrecision := time.Since(t.timeStart), p.style.Options.TimeInProgressPrecision
+	if isDone {
+		timeTaken, timePrecision = t.timeStop.Sub(t.timeStart), p.style.Options.TimeDonePrecision
+	}
+	t.mutexPrv.RUnlock()
+	fields.Time = p.style.Colors.Time.SprintProfile(colorProfile, timeTaken.Round(timePrecision))
+	switch {
+	case t.IsErrored():
+		fields.Status = p.style.Colors.Error.SprintProfile(colorProfile, p.style.Options.ErrorString)
+	case t.IsCancelled():
+		fields.Status = p.style.Colors.Message.SprintProfile(colorProfile, p.style.Options.CancelledString)
+	case isDone:
+		fields.Status = p.style.Colors.Message.SprintProfile(colorProfile, p.style.Options.DoneString)
+	default:
+		if eta := t.ETA().Round(p.style.Options.ETAPrecision); eta > p.style.Options.ETAPrecision {
+			fields.ETA = p.style.Colors.Time.SprintProfile(colorProfile, eta)
+		}
+	}
+	if err := t.Err(); err != nil {
+		// keep the error message on the same line as the tracker
+		errMsg := strings.Join(strings.Fields(err.Error()), " ")
+		fields.Error = p.style.Colors.Error.SprintProfile(colorProfile, errMsg)
+	}
+	return fields
+}
+
+// renderTrackerLayout renders the Tracker using the layout template, and
+// returns false if the template could not be executed.
+func (p *Progress) renderTrackerLayout(out *strings.Builder, t *Tracker, message string, hint renderHint) bool {
+	var line strings.Builder
+	if err := p.layout.Execute(&line, p.generateLayoutFields(t, message, hint)); err != nil {
+		return false
+	}
+	// keep the whole Tracker on a single line
+	out.WriteString(strings.NewReplacer("\n", " ", "\r", "").Replace(line.String()))
+	out.WriteRune('\n')
+	return true
+}
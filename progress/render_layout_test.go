@@ -0,0 +1,88 @@
+package progress
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProgress_RenderSomeTrackers_WithLayout(t *testing.T) {
+	renderOutput := outputWriter{}
+
+	pw := generateWriter()
+	pw.SetMessageWidth(12)
+	pw.SetOutputWriter(&renderOutput)
+	pw.ShowPercentage(false)
+	pw.Style().Options.ETAPrecision = time.Millisecond
+	pw.Style().Options.Layout = "{{.Message}} {{.Bar}} {{.Percent}} {{.Value | padLeft 7}}/{{.Total}} {{.Speed}} ETA {{.ETA}}{{.Status}}{{.Error}}"
+	trackerErrored := &Tracker{Message: "Downloading File # 2", Total: 1000, Units: UnitsBytes}
+	go trackSomething(pw, &Tracker{Message: "Downloading File # 1", Total: 1000, Units: UnitsBytes})
+	pw.AppendTracker(trackerErrored)
+	trackerErrored.Increment(250)
+	trackerErrored.MarkAsErrored(errors.New("connection\nreset"))
+	renderAndWait(pw, false)
+
+	expectedOutPatterns := []*regexp.Regexp{
+		regexp.MustCompile(`\x1b\[KDownloading~ \[[#.]{23}] \d+\.\d+% +\d+B/1\.00KB [\d.]+[KMG]?B/s ETA [\d.]+m?s\n`),
+		regexp.MustCompile(`\x1b\[KDownloading~ \[#{23}] 100\.00%  1\.00KB/1\.00KB [\d.]+[KMG]?B/s ETA done!\n`),
+		regexp.MustCompile(`\x1b\[KDownloading~ \[#{5}[#.]\.{17}] 25\.00%    250B/1\.00KB [\d.]+[KMG]?B/s ETA error!connection reset\n`),
+	}
+	out := renderOutput.String()
+	for _, expectedOutPattern := range expectedOutPatterns {
+		if !expectedOutPattern.MatchString(out) {
+			assert.Fail(t, "Failed to find a pattern in the Output.", expectedOutPattern.String())
+		}
+	}
+	assert.NotContains(t, out, " ... ")
+	showOutputOnFailure(t, out)
+}
+
+func TestProgress_RenderSomeTrackers_WithLayout_Invalid(t *testing.T) {
+	for _, layout := range []string{"{{.Message", "{{.Unknown}}"} {
+		renderOutput := outputWriter{}
+
+		pw := generateWriter()
+		pw.SetOutputWriter(&renderOutput)
+		pw.Style().Options.Layout = layout
+		go trackSomething(pw, &Tracker{Message: "Calculating Total   # 1", Total: 1000, Units: UnitsDefault})
+		renderAndWait(pw, false)
+
+		// falls back to the built-in layout
+		expectedOutPatterns := []*regexp.Regexp{
+			regexp.MustCompile(`\x1b\[KCalculating Total   # 1 \.\.\. \d+\.\d+% \[[#.]{23}] \[\d+ in [\d.]+ms]`),
+			regexp.MustCompile(`\x1b\[KCalculating Total   # 1 \.\.\. done! \[\d+\.\d+K in [\d.]+ms]`),
+		}
+		out := renderOutput.String()
+		for _, expectedOutPattern := range expectedOutPatterns {
+			if !expectedOutPattern.MatchString(out) {
+				assert.Fail(t, "Failed to find a pattern in the Output.", expectedOutPattern.String())
+			}
+		}
+		showOutputOnFailure(t, out)
+	}
+}
+
+func TestProgress_layoutFuncs(t *testing.T) {
+	p := Progress{}
+	p.Style().Options.SnipIndicator = "…"
+	funcs := p.layoutFuncs()
+	pad := funcs["pad"].(func(int, string) string)
+	padLeft := funcs["padLeft"].(func(int, string) string)
+	snip := funcs["snip"].(func(int, string) string)
+
+	colored := text.FgRed.Sprint("Ghost")
+	assert.Equal(t, "Ghost  ", pad(7, "Ghost"))
+	assert.Equal(t, colored+"  ", pad(7, colored))
+	assert.Equal(t, "Ghost", pad(3, "Ghost"))
+	assert.Equal(t, "  Ghost", padLeft(7, "Ghost"))
+	assert.Equal(t, "  "+colored, padLeft(7, colored))
+	assert.Equal(t, "Ghost", padLeft(3, "Ghost"))
+	assert.Equal(t, "Gh…", snip(3, "Ghost"))
+	assert.Equal(t, "Ghost", snip(7, "Ghost"))
+	assert.True(t, strings.HasSuffix(snip(3, colored), "…"))
+}
@@ -0,0 +1,143 @@
+package progress
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/text"
+)
+
+// trackerLogState is the state of a Tracker when it was last logged in
+// RenderModeLog (or emitted as an event in RenderModeJSON).
+type trackerLogState struct {
+	done       bool
+	id         int
+	subMessage string
+	time       time.Time
+	value      int64
+}
+
+// renderLogSummary logs the Trackers that changed since the last render, and
+// a summary of all the Trackers.
+func (p *Progress) renderLogSummary() {
+	p.overallTrackerMutex.RLock()
+	overallTracker := p.overallTracker
+	p.overallTrackerMutex.RUnlock()
+	if overallTracker == nil {
+		return
+	}
+
+	trackers := p.getAllTrackers()
+	var out strings.Builder
+	var numDone, numErrored, numCancelled, numActive int
+	for _, tracker := range trackers {
+		p.renderTrackerTreeLog(&out, tracker, "")
+		switch {
+		case tracker.IsErrored():
+			numErrored++
+		case tracker.IsCancelled():
+			numCancelled++
+		case tracker.IsDone():
+			numDone++
+		default:
+			numActive++
+		}
+	}
+
+	stats := []string{fmt.Sprintf("%d done", numDone)}
+	if numErrored > 0 {
+		stats = append(stats, fmt.Sprintf("%d errored", numErrored))
+	}
+	if numCancelled > 0 {
+		stats = append(stats, fmt.Sprintf("%d cancelled", numCancelled))
+	}
+	if numActive > 0 {
+		stats = append(stats, fmt.Sprintf("%d in progress", numActive))
+	}
+	timeTaken := time.Since(overallTracker.timeStart)
+	if overallTracker.IsDone() {
+		timeTaken = overallTracker.timeStop.Sub(overallTracker.timeStart)
+	}
+	timeTaken = timeTaken.Round(p.style.Options.TimeOverallPrecision)
+	out.WriteString(fmt.Sprintf("[summary] %d trackers in %v: %s\n", len(trackers), timeTaken, strings.Join(stats, ", ")))
+
+	_, _ = p.outputWriter.Write([]byte(out.String()))
+}
+
+// renderTrackerLog logs a line for the Tracker if it just started or ended,
+// or if it made progress and was not logged within the last log interval.
+func (p *Progress) renderTrackerLog(out *strings.Builder, t *Tracker, message string) {
+	now, isDone, value := time.Now(), t.IsDone(), t.Value()
+	if state, ok := p.logStates[t]; ok {
+		if state.done || (!isDone && (value == state.value || now.Sub(state.time) < p.logInterval)) {
+			return
+		}
+	}
+	p.logStates[t] = trackerLogState{done: isDone, time: now, value: value}
+
+	t.mutex.RLock()
+	total, units := t.Total, t.Units
+	t.mutex.RUnlock()
+
+	// status
+	out.WriteRune('[')
+	switch {
+	case t.IsErrored():
+		out.WriteString(p.style.Options.ErrorString)
+	case t.IsCancelled():
+		out.WriteString(p.style.Options.CancelledString)
+	case isDone:
+		out.WriteString(p.style.Options.DoneString)
+	case total == 0:
+		out.WriteString(strings.TrimSpace(p.style.Options.PercentIndeterminate))
+	default:
+		out.WriteString(fmt.Sprintf("%d%%", int64(t.PercentDone())))
+	}
+	out.WriteString("] ")
+	out.WriteString(message)
+
+	// stats
+	var stats []string
+	if !p.hideValue {
+		if isDone || total == 0 {
+			stats = append(stats, units.Sprint(value))
+		} else {
+			stats = append(stats, units.Sprint(value)+"/"+units.Sprint(total))
+		}
+	}
+	if isDone && !p.hideTime {
+		timeTaken := t.timeStop.Sub(t.timeStart).Round(p.style.Options.TimeDonePrecision)
+		stats = append(stats, fmt.Sprintf("in %v", timeTaken))
+	}
+	if p.showSpeed {
+		var speed strings.Builder
+		p.renderTrackerStatsSpeed(&speed, t)
+		stats = append(stats, "@ "+text.StripEscape(speed.String()))
+	}
+	stats = append(stats, t.metadataPairs()...)
+	if len(stats) > 0 {
+		out.WriteString(" (" + strings.Join(stats, " ") + ")")
+	}
+	if err := t.Err(); err != nil {
+		out.WriteString(": " + strings.Join(strings.Fields(err.Error()), " "))
+	} else if subMessage := t.SubMessage(); subMessage != "" && !isDone {
+		out.WriteString(" - " + strings.Join(strings.Fields(text.StripEscape(subMessage)), " "))
+	}
+	out.WriteRune('\n')
+}
+
+// renderTrackerTreeLog logs the Tracker and its children; the messages of the
+// children are prefixed with those of their parents.
+func (p *Progress) renderTrackerTreeLog(out *strings.Builder, t *Tracker, path string) {
+	message := strings.TrimSpace(text.StripEscape(t.message()))
+	message = strings.NewReplacer("\t", " ", "\r", "").Replace(message)
+	if path != "" {
+		message = path + " / " + message
+	}
+
+	p.renderTrackerLog(out, t, message)
+	for _, child := range t.Children() {
+		p.renderTrackerTreeLog(out, child, message)
+	}
+}
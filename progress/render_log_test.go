@@ -0,0 +1,118 @@
+package progress
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProgress_RenderLog(t *testing.T) {
+	renderOutput := outputWriter{}
+
+	pw := generateWriter()
+	pw.SetLogInterval(time.Millisecond * 150)
+	pw.SetOutputWriter(&renderOutput)
+	pw.SetRenderMode(RenderModeLog)
+	pw.ShowOverallTracker(true)
+	trackerErrored := &Tracker{Message: "Downloading File    # 2", Total: 1000, Units: UnitsBytes}
+	trackerCancelled := &Tracker{Message: "Transferring Amount # 3", Total: 1000, Units: UnitsCurrencyDollar}
+	go trackSomething(pw, &Tracker{Message: "Downloading File\t# 1", Total: 1000, Units: UnitsBytes})
+	pw.AppendTrackers([]*Tracker{trackerErrored, trackerCancelled})
+	trackerErrored.Increment(250)
+	trackerErrored.MarkAsErrored(errors.New("connection\nreset"))
+	trackerCancelled.MarkAsCancelled()
+	renderAndWait(pw, false)
+	for pw.IsRenderInProgress() {
+		time.Sleep(time.Millisecond * 10)
+	}
+
+	expectedOutPatterns := []*regexp.Regexp{
+		regexp.MustCompile(`(?m)^\[\d+%] Downloading File # 1 \(\d+(\.\d+K)?B/1\.00KB\)$`),
+		regexp.MustCompile(`(?m)^\[done!] Downloading File # 1 \(1\.00KB in [\d.]+ms\)$`),
+		regexp.MustCompile(`(?m)^\[error!] Downloading File    # 2 \(250B in [\d.]+[µm]?s\): connection reset$`),
+		regexp.MustCompile(`(?m)^\[cancelled!] Transferring Amount # 3 \(\$0 in [\d.]+[µm]?s\)$`),
+		regexp.MustCompile(`\[summary] 3 trackers in \d+s: 1 done, 1 errored, 1 cancelled\n$`),
+	}
+	out := renderOutput.String()
+	for _, expectedOutPattern := range expectedOutPatterns {
+		if !expectedOutPattern.MatchString(out) {
+			assert.Fail(t, "Failed to find a pattern in the Output.", expectedOutPattern.String())
+		}
+	}
+	assert.NotContains(t, out, "\x1b")
+	// the progress is logged at most once every log interval
+	numLines := strings.Count(out, "Downloading File # 1")
+	assert.True(t, numLines >= 2 && numLines <= 4, numLines)
+	assert.Equal(t, 1, strings.Count(out, "Downloading File    # 2"))
+	showOutputOnFailure(t, out)
+}
+
+func TestProgress_RenderLog_WithChildren(t *testing.T) {
+	renderOutput := outputWriter{}
+
+	pw := generateWriter()
+	pw.SetOutputWriter(&renderOutput)
+	pw.SetRenderMode(RenderModeLog)
+	pw.ShowSpeed(true)
+	pw.ShowTime(false)
+	tracker := &Tracker{Message: "Deploying", Units: UnitsBytes}
+	tracker.AppendChildren([]*Tracker{
+		{Message: "Uploading # 1", Total: 1000, Units: UnitsBytes},
+		{Message: "Uploading # 2", Total: 1000, Units: UnitsBytes},
+	})
+	go trackSomethingWithChildren(pw, tracker)
+	renderAndWait(pw, false)
+	for pw.IsRenderInProgress() {
+		time.Sleep(time.Millisecond * 10)
+	}
+
+	expectedOutPatterns := []*regexp.Regexp{
+		regexp.MustCompile(`(?m)^\[0%] Deploying \(0B/2\.00KB @ 0B/s\)$`),
+		regexp.MustCompile(`(?m)^\[0%] Deploying / Uploading # 1 \(0B/1\.00KB @ 0B/s\)$`),
+		regexp.MustCompile(`(?m)^\[done!] Deploying / Uploading # 1 \(999B @ [\d.]+K?B/s\)$`),
+		regexp.MustCompile(`(?m)^\[done!] Deploying / Uploading # 2 \(999B @ [\d.]+K?B/s\)$`),
+		regexp.MustCompile(`(?m)^\[done!] Deploying \(2\.00KB @ [\d.]+K?B/s\)$`),
+		regexp.MustCompile(`\[summary] 1 trackers in \d+s: 1 done\n$`),
+	}
+	out := renderOutput.String()
+	for _, expectedOutPattern := range expectedOutPatterns {
+		if !expectedOutPattern.MatchString(out) {
+			assert.Fail(t, "Failed to find a pattern in the Output.", expectedOutPattern.String())
+		}
+	}
+	assert.NotContains(t, out, "\x1b")
+	showOutputOnFailure(t, out)
+}
+
+func TestProgress_RenderLog_WithSubMessageAndMetadata(t *testing.T) {
+	renderOutput := outputWriter{}
+
+	pw := generateWriter()
+	pw.SetOutputWriter(&renderOutput)
+	pw.SetRenderMode(RenderModeLog)
+	pw.ShowTime(false)
+	tracker := &Tracker{Message: "Downloading Files", Total: 1000, Units: UnitsBytes}
+	tracker.SetMetadata("host", "web-01")
+	tracker.UpdateSubMessage("foo\tbar.txt")
+	go trackSomething(pw, tracker)
+	renderAndWait(pw, false)
+	for pw.IsRenderInProgress() {
+		time.Sleep(time.Millisecond * 10)
+	}
+
+	expectedOutPatterns := []*regexp.Regexp{
+		regexp.MustCompile(`(?m)^\[0%] Downloading Files \(0B/1\.00KB host=web-01\) - foo bar\.txt$`),
+		regexp.MustCompile(`(?m)^\[done!] Downloading Files \(1\.00KB host=web-01\)$`),
+	}
+	out := renderOutput.String()
+	for _, expectedOutPattern := range expectedOutPatterns {
+		if !expectedOutPattern.MatchString(out) {
+			assert.Fail(t, "Failed to find a pattern in the Output.", expectedOutPattern.String())
+		}
+	}
+	showOutputOnFailure(t, out)
+}
@@ -1,25 +1,34 @@
 package progress
 
 import (
+	"errors"
 	"fmt"
 	"regexp"
 	"sort"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/jedib0t/go-pretty/v6/list"
+	"github.com/jedib0t/go-pretty/v6/text"
 	"github.com/stretchr/testify/assert"
 )
 
 type outputWriter struct {
-	Text strings.Builder
+	Text  strings.Builder
+	mutex sync.Mutex
 }
 
 func (w *outputWriter) Write(p []byte) (n int, err error) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
 	return w.Text.Write(p)
 }
 
 func (w *outputWriter) String() string {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
 	return w.Text.String()
 }
 
@@ -60,6 +69,38 @@ func trackSomething(pw Writer, tracker *Tracker) {
 	}
 }
 
+func trackSomethingWithChildren(pw Writer, tracker *Tracker) {
+	pw.AppendTracker(tracker)
+
+	// nextLeaf returns the first child (with no children) that is not done
+	var nextLeaf func(t *Tracker) *Tracker
+	nextLeaf = func(t *Tracker) *Tracker {
+		children := t.Children()
+		if len(children) == 0 {
+			return t
+		}
+		for _, child := range children {
+			if !child.IsDone() {
+				return nextLeaf(child)
+			}
+		}
+		return nil
+	}
+
+	c := time.Tick(time.Millisecond * 100)
+	for !tracker.IsDone() {
+		select {
+		case <-c:
+			if leaf := nextLeaf(tracker); leaf != nil {
+				leaf.Increment(leaf.Total / 3)
+				if leaf.Value()*3 >= leaf.Total*2 {
+					leaf.MarkAsDone()
+				}
+			}
+		}
+	}
+}
+
 func trackSomethingIndeterminate(pw Writer, tracker *Tracker) {
 	incrementPerCycle := tracker.Total / 3
 	total := tracker.Total
@@ -469,6 +510,42 @@ func TestProgress_RenderSomeTrackers_WithAutoStop(t *testing.T) {
 	showOutputOnFailure(t, out)
 }
 
+func TestProgress_RenderSomeTrackers_WithErrors(t *testing.T) {
+	renderOutput := outputWriter{}
+
+	pw := generateWriter()
+	pw.SetOutputWriter(&renderOutput)
+	pw.SetTrackerPosition(PositionRight)
+	pw.ShowOverallTracker(true)
+	trackerErrored := &Tracker{Message: "Downloading File    # 2", Total: 1000, Units: UnitsBytes}
+	trackerCancelled := &Tracker{Message: "Transferring Amount # 3", Total: 1000, Units: UnitsCurrencyDollar}
+	go trackSomething(pw, &Tracker{Message: "Calculating Total   # 1", Total: 1000, Units: UnitsDefault})
+	pw.AppendTrackers([]*Tracker{trackerErrored, trackerCancelled})
+	trackerErrored.Increment(250)
+	trackerErrored.MarkAsErrored(errors.New("connection\nreset"))
+	trackerCancelled.MarkAsCancelled()
+	renderAndWait(pw, false)
+
+	expectedOutPatterns := []*regexp.Regexp{
+		regexp.MustCompile(`\x1b\[KCalculating Total   # 1 \.\.\. done! \[\d+\.\d+K in [\d.]+ms]`),
+		regexp.MustCompile(`\x1b\[KDownloading File    # 2 \.\.\. error! \[250B in [\d.]+[µm]?s]: connection reset\n`),
+		regexp.MustCompile(`\x1b\[KTransferring Amount # 3 \.\.\. cancelled! \[\$0 in [\d.]+[µm]?s]\n`),
+	}
+	out := renderOutput.String()
+	for _, expectedOutPattern := range expectedOutPatterns {
+		if !expectedOutPattern.MatchString(out) {
+			assert.Fail(t, "Failed to find a pattern in the Output.", expectedOutPattern.String())
+		}
+	}
+	assert.Equal(t, 3, pw.LengthDone())
+	assert.Equal(t, 1, pw.LengthErrored())
+	if p, ok := pw.(*Progress); ok {
+		assert.True(t, p.overallTracker.IsErrored())
+		assert.EqualError(t, p.overallTracker.Err(), "1 of 3 trackers failed")
+	}
+	showOutputOnFailure(t, out)
+}
+
 func TestProgress_RenderSomeTrackers_WithIndeterminateTracker(t *testing.T) {
 	renderOutput := outputWriter{}
 
@@ -585,6 +662,106 @@ func TestProgress_RenderSomeTrackers_WithOverallTracker(t *testing.T) {
 	showOutputOnFailure(t, out)
 }
 
+func TestProgress_RenderSomeTrackers_WithChildren(t *testing.T) {
+	renderOutput := outputWriter{}
+
+	pw := generateWriter()
+	pw.SetMessageWidth(20)
+	pw.SetOutputWriter(&renderOutput)
+	pw.SetTrackerPosition(PositionRight)
+	pw.ShowValue(false)
+	tracker := &Tracker{Message: "Deploying"}
+	tracker.AppendChildren([]*Tracker{
+		{Message: "Uploading # 1", Total: 1000, Units: UnitsBytes},
+		{Message: "Uploading # 2", Total: 1000, Units: UnitsBytes},
+	})
+	tracker.Children()[1].AppendChild(&Tracker{Message: "Verifying", Total: 3})
+	go trackSomethingWithChildren(pw, tracker)
+	renderAndWait(pw, false)
+
+	expectedOutPatterns := []*regexp.Regexp{
+		regexp.MustCompile(`\x1b\[KDeploying            \.\.\. +\d+\.\d+% \[[#.]{23}] \[[\d.]+[µm]?s]\n` +
+			`\x1b\[K├─ Uploading # 1     \.\.\. +\d+\.\d+% \[[#.]{23}] \[[\d.]+[µm]?s]\n` +
+			`\x1b\[K└─ Uploading # 2     \.\.\. +\d+\.\d+% \[[#.]{23}] \[[\d.]+[µm]?s]\n` +
+			`\x1b\[K   └─ Verifying      \.\.\. +\d+\.\d+% \[[#.]{23}] \[[\d.]+[µm]?s]\n`),
+		regexp.MustCompile(`\x1b\[KDeploying            \.\.\. done! \[[\d.]+ms]\n` +
+			`\x1b\[K├─ Uploading # 1 +\.\.\. done! \[[\d.]+ms]\n` +
+			`\x1b\[K└─ Uploading # 2 +\.\.\. done! \[[\d.]+ms]\n` +
+			`\x1b\[K   └─ Verifying +\.\.\. done! \[[\d.]+ms]\n`),
+	}
+	out := renderOutput.String()
+	for _, expectedOutPattern := range expectedOutPatterns {
+		if !expectedOutPattern.MatchString(out) {
+			assert.Fail(t, "Failed to find a pattern in the Output.", expectedOutPattern.String())
+		}
+	}
+	showOutputOnFailure(t, out)
+}
+
+func TestProgress_RenderSomeTrackers_WithChildren_HideDone(t *testing.T) {
+	renderOutput := outputWriter{}
+
+	pw := generateWriter()
+	pw.SetOutputWriter(&renderOutput)
+	pw.SetTrackerPosition(PositionRight)
+	pw.ShowDoneChildren(false)
+	pw.ShowValue(false)
+	pw.Style().Tree = list.StyleConnectedRounded
+	tracker := &Tracker{Message: "Deploying"}
+	tracker.AppendChildren([]*Tracker{
+		{Message: "Uploading # 1", Total: 1000, Units: UnitsBytes},
+		{Message: "Uploading # 2", Total: 1000, Units: UnitsBytes},
+	})
+	go trackSomethingWithChildren(pw, tracker)
+	renderAndWait(pw, false)
+
+	expectedOutPatterns := []*regexp.Regexp{
+		regexp.MustCompile(`\x1b\[KDeploying \.\.\. +\d+\.\d+% \[[#.]{23}] \[[\d.]+[µm]?s]\n` +
+			`\x1b\[K├─ Uploading # 1 \.\.\. +\d+\.\d+% \[[#.]{23}] \[[\d.]+[µm]?s]\n` +
+			`\x1b\[K╰─ Uploading # 2 \.\.\. +\d+\.\d+% \[[#.]{23}] \[[\d.]+[µm]?s]\n`),
+		regexp.MustCompile(`\x1b\[KDeploying \.\.\. +\d+\.\d+% \[[#.]{23}] \[[\d.]+[µm]?s]\n` +
+			`\x1b\[K╰─ Uploading # 2 \.\.\. +\d+\.\d+% \[[#.]{23}] \[[\d.]+[µm]?s]\n`),
+		regexp.MustCompile(`\x1b\[KDeploying \.\.\. done! \[[\d.]+ms]\n$`),
+	}
+	out := renderOutput.String()
+	for _, expectedOutPattern := range expectedOutPatterns {
+		if !expectedOutPattern.MatchString(out) {
+			assert.Fail(t, "Failed to find a pattern in the Output.", expectedOutPattern.String())
+		}
+	}
+	showOutputOnFailure(t, out)
+}
+
+func TestProgress_RenderSomeTrackers_WithSpeed(t *testing.T) {
+	renderOutput := outputWriter{}
+
+	pw := generateWriter()
+	pw.SetOutputWriter(&renderOutput)
+	pw.SetTrackerPosition(PositionRight)
+	pw.ShowOverallTracker(false)
+	pw.ShowSpeed(true)
+	go trackSomething(pw, &Tracker{Message: "Calculating Total   # 1\r", Total: 1000, Units: UnitsDefault})
+	go trackSomething(pw, &Tracker{Message: "Downloading File\t# 2", Total: 1000, Units: UnitsBytes})
+	go trackSomething(pw, &Tracker{Message: "Transferring Amount # 3", Total: 1000, Units: UnitsCurrencyDollar})
+	renderAndWait(pw, false)
+
+	expectedOutPatterns := []*regexp.Regexp{
+		regexp.MustCompile(`\x1b\[KCalculating Total   # 1 \.\.\. \d+\.\d+% \[[#.]{23}] \[\d+ in [\d.]+[µm]?s @ [\d.]+K?/s]`),
+		regexp.MustCompile(`\x1b\[KDownloading File    # 2 \.\.\. \d+\.\d+% \[[#.]{23}] \[\d+B in [\d.]+[µm]?s @ [\d.]+K?B/s]`),
+		regexp.MustCompile(`\x1b\[KTransferring Amount # 3 \.\.\. \d+\.\d+% \[[#.]{23}] \[\$\d+ in [\d.]+[µm]?s @ \$[\d.]+K?/s]`),
+		regexp.MustCompile(`\x1b\[KCalculating Total   # 1 \.\.\. done! \[\d+\.\d+K in [\d.]+ms @ [\d.]+K?/s]`),
+		regexp.MustCompile(`\x1b\[KDownloading File    # 2 \.\.\. done! \[\d+\.\d+KB in [\d.]+ms @ [\d.]+K?B/s]`),
+		regexp.MustCompile(`\x1b\[KTransferring Amount # 3 \.\.\. done! \[\$\d+\.\d+K in [\d.]+ms @ \$[\d.]+K?/s]`),
+	}
+	out := renderOutput.String()
+	for _, expectedOutPattern := range expectedOutPatterns {
+		if !expectedOutPattern.MatchString(out) {
+			assert.Fail(t, "Failed to find a pattern in the Output.", expectedOutPattern.String())
+		}
+	}
+	showOutputOnFailure(t, out)
+}
+
 func TestProgress_RenderSomeTrackers_WithoutOverallTracker_WithETA(t *testing.T) {
 	renderOutput := outputWriter{}
 
@@ -615,3 +792,101 @@ func TestProgress_RenderSomeTrackers_WithoutOverallTracker_WithETA(t *testing.T)
 	}
 	showOutputOnFailure(t, out)
 }
+
+func TestProgress_RenderSomeTrackers_WithSubMessageAndMetadata(t *testing.T) {
+	renderOutput := outputWriter{}
+
+	pw := generateWriter()
+	pw.SetMessageWidth(24)
+	pw.SetOutputWriter(&renderOutput)
+	tracker := &Tracker{Message: "Downloading File\t# 1", Total: 999, Units: UnitsBytes}
+	tracker.SetMetadata("host", "web-01")
+	trackerParent := &Tracker{Message: "Deploying"}
+	trackerParent.AppendChild(&Tracker{Message: "Uploading", Total: 1000, Units: UnitsBytes})
+	trackerParent.UpdateSubMessage("waiting for\nthe hosts")
+	go trackSomethingWithChildren(pw, trackerParent)
+	pw.AppendTracker(tracker)
+	go func() {
+		for idx := 1; idx <= 3; idx++ {
+			time.Sleep(time.Millisecond * 100)
+			tracker.UpdateMessage(fmt.Sprintf("Downloading File # %d", idx))
+			tracker.UpdateSubMessage(fmt.Sprintf("file-%d.txt", idx))
+			tracker.Increment(333)
+		}
+	}()
+	renderAndWait(pw, false)
+
+	expectedOutPatterns := []*regexp.Regexp{
+		regexp.MustCompile(`\x1b\[KDeploying +\.\.\. \d+\.\d+% \[[#.]{23}] \[\d+ in [\d.]+[µm]?s]\n\x1b\[K│  waiting for the hosts\n\x1b\[K└─ Uploading`),
+		regexp.MustCompile(`\x1b\[KDownloading File    # 1 +\.\.\. +\d+\.\d+% \[[#.]{23}] \[\d+B in [\d.]+[µm]?s; host=web-01]\n`),
+		regexp.MustCompile(`\x1b\[KDownloading File # 1 +\.\.\. \d+\.\d+% \[[#.]{23}] \[\d+B in [\d.]+[µm]?s; host=web-01]\n\x1b\[K   file-1\.txt\n`),
+		regexp.MustCompile(`\x1b\[KDownloading File # 3 +\.\.\. done! \[999B in [\d.]+ms; host=web-01]\n`),
+	}
+	out := renderOutput.String()
+	for _, expectedOutPattern := range expectedOutPatterns {
+		if !expectedOutPattern.MatchString(out) {
+			assert.Fail(t, "Failed to find a pattern in the Output.", expectedOutPattern.String())
+		}
+	}
+	assert.NotRegexp(t, `done! .*\n\x1b\[K   file-3\.txt\n`, out)
+	// the renderer does not modify the Message
+	assert.Equal(t, "Downloading File # 3", tracker.Message)
+	assert.Equal(t, "Deploying", trackerParent.Message)
+	showOutputOnFailure(t, out)
+}
+
+func TestProgress_RenderSomeTrackers_WithTerminalWidth(t *testing.T) {
+	renderOutput := outputWriter{}
+	terminalWidth := 100
+
+	pw := generateWriter()
+	pw.SetMessageWidth(20)
+	pw.SetOutputWriter(&renderOutput)
+	pw.ShowOverallTracker(true)
+	pw.ShowTime(false)
+	p := pw.(*Progress)
+	p.terminalWidthProvider = func() int { return terminalWidth }
+	tracker1 := &Tracker{Message: "Downloading File # 1", Total: 1000, Units: UnitsBytes}
+	tracker2 := &Tracker{Message: "Downloading File # 2", Total: 1000, Units: UnitsBytes}
+	tracker2.UpdateSubMessage("copying a file with a really long name.txt")
+	pw.AppendTrackers([]*Tracker{tracker1, tracker2})
+	p.initForRender()
+	tracker1.Increment(333)
+
+	// fits in the terminal as it is
+	lastRenderLength := p.renderTrackers(0)
+	out := renderOutput.String()
+	assert.Equal(t, "\x1b[KDownloading File # 1 ... 33.30% [#######................] [333B]\n"+
+		"\x1b[KDownloading File # 2 ...  0.00% [.......................] [0B]\n"+
+		"\x1b[K   copying a file w~\n"+
+		"\x1b[K[########..............................................] [0s; ~ETA: 0s]\n", out)
+	assert.Equal(t, []int{64, 62, 20, 71}, p.linesActive)
+
+	// the wider lines rendered before wrap around once the terminal gets
+	// narrower, and the progress bars shrink (followed by the messages) to fit
+	terminalWidth = 40
+	renderOutput.Text.Reset()
+	lastRenderLength = p.renderTrackers(lastRenderLength)
+	out = renderOutput.String()
+	assert.Equal(t, "\x1b[7A\x1b[KDownloading File ~ ... 33.30% [.] [333B]\n"+
+		"\x1b[KDownloading File # 2 ...  0.00% [.] [0B]\n"+
+		"\x1b[K   copying a file w~\n"+
+		"\x1b[K[###....................] [0s; ~ETA: 0s]\n", out)
+
+	terminalWidth = 30
+	renderOutput.Text.Reset()
+	p.renderTrackers(lastRenderLength)
+	out = renderOutput.String()
+	assert.True(t, strings.HasPrefix(out, "\x1b[7A"), out)
+	for _, line := range strings.Split(strings.TrimSuffix(out, "\n"), "\n") {
+		line = strings.TrimPrefix(line, "\x1b[7A")
+		assert.True(t, text.RuneCount(strings.TrimPrefix(line, "\x1b[K")) <= 30, line)
+	}
+	assert.Contains(t, out, "\x1b[KDownloa~ ... 33.30% [.] [333B]\n")
+	showOutputOnFailure(t, out)
+}
+
+func Test_lineWidths(t *testing.T) {
+	assert.Equal(t, []int{}, lineWidths(""))
+	assert.Equal(t, []int{3, 0, 7}, lineWidths("\x1b[Kfoo\n\x1b[K\n\x1b[K\x1b[91mfoo \x1b[0mbar\x1b[0m\n"))
+}
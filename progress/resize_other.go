@@ -0,0 +1,13 @@
+// +build !darwin,!dragonfly,!freebsd,!linux,!netbsd,!openbsd,!solaris
+
+package progress
+
+import (
+	"os"
+)
+
+// notifyOnResize does nothing as there is no signal sent when the terminal
+// gets resized on this platform; the width of the terminal is still picked up
+// on every render cycle.
+func notifyOnResize(c chan<- os.Signal) {
+}
@@ -0,0 +1,15 @@
+// +build darwin dragonfly freebsd linux netbsd openbsd solaris
+
+package progress
+
+import (
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+// notifyOnResize relays the signal sent when the terminal gets resized
+// (SIGWINCH) to the channel.
+func notifyOnResize(c chan<- os.Signal) {
+	signal.Notify(c, syscall.SIGWINCH)
+}
@@ -0,0 +1,72 @@
+package progress
+
+import (
+	"time"
+)
+
+// Spinner defines the frames of a spinner, which are cycled through (one per
+// render cycle) to indicate that a task is in progress without showing how
+// far along it is.
+type Spinner []string
+
+var (
+	// SpinnerArc uses UNICODE Arc runes to render the spinner.
+	SpinnerArc = Spinner{"◜", "◠", "◝", "◞", "◡", "◟"}
+
+	// SpinnerCircle uses UNICODE Circle runes to render the spinner.
+	SpinnerCircle = Spinner{"◐", "◓", "◑", "◒"}
+
+	// SpinnerDots uses UNICODE Braille patterns to render the spinner.
+	SpinnerDots = Spinner{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
+
+	// SpinnerLine uses simple ASCII characters to render the spinner.
+	SpinnerLine = Spinner{"-", "\\", "|", "/"}
+
+	// SpinnerQuadrants uses UNICODE Block Drawing quadrants to render the
+	// spinner.
+	SpinnerQuadrants = Spinner{"▖", "▘", "▝", "▗"}
+)
+
+var (
+	// DefaultSpinUpdateFrequency defines a sane value for the frequency with
+	// which the spinner of Spin moves.
+	DefaultSpinUpdateFrequency = time.Millisecond * 100
+)
+
+// Spin renders a spinner with the message on os.Stdout while the function
+// runs, and ends with the done (or error) string once it returns. The error
+// returned by the function is returned as it is. For ex.:
+//  err := progress.Spin("Loading config", func() error {
+//    return loadConfig(configFile)
+//  })
+// renders this while loadConfig runs:
+//  Loading config ... ⠹ [in 312ms]
+// and this once it is done:
+//  Loading config ... done! [in 1.02s]
+func Spin(message string, fn func() error) error {
+	return spin(NewWriter(), message, fn)
+}
+
+func spin(pw Writer, message string, fn func() error) error {
+	pw.SetAutoStop(true)
+	pw.SetTrackerPosition(PositionRight)
+	pw.SetUpdateFrequency(DefaultSpinUpdateFrequency)
+	pw.ShowValue(false)
+	tracker := &Tracker{Message: message, Spinner: SpinnerDots}
+	pw.AppendTracker(tracker)
+
+	rendered := make(chan bool)
+	go func() {
+		pw.Render()
+		close(rendered)
+	}()
+	err := fn()
+	if err != nil {
+		tracker.MarkAsErrored(err)
+	} else {
+		tracker.MarkAsDone()
+	}
+	// wait for the final state of the Tracker to get rendered
+	<-rendered
+	return err
+}
@@ -0,0 +1,71 @@
+package progress
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProgress_RenderSomeTrackers_WithSpinner(t *testing.T) {
+	renderOutput := outputWriter{}
+
+	pw := generateWriter()
+	pw.SetOutputWriter(&renderOutput)
+	pw.ShowValue(false)
+	go trackSomething(pw, &Tracker{Message: "Calculating Total   # 1", Total: 1000, Spinner: SpinnerLine})
+	go trackSomething(pw, &Tracker{Message: "Downloading File    # 2", Total: 1000, Units: UnitsBytes})
+	renderAndWait(pw, false)
+
+	expectedOutPatterns := []*regexp.Regexp{
+		regexp.MustCompile(`\x1b\[KCalculating Total   # 1 \.\.\. [-\\|/] \[[\d.]+[µm]?s]\n`),
+		regexp.MustCompile(`\x1b\[KDownloading File    # 2 \.\.\. \d+\.\d+% \[[#.]{23}] \[[\d.]+[µm]?s]\n`),
+		regexp.MustCompile(`\x1b\[KCalculating Total   # 1 \.\.\. done! \[[\d.]+ms]\n`),
+		regexp.MustCompile(`\x1b\[KDownloading File    # 2 \.\.\. done! \[[\d.]+ms]\n`),
+	}
+	out := renderOutput.String()
+	for _, expectedOutPattern := range expectedOutPatterns {
+		if !expectedOutPattern.MatchString(out) {
+			assert.Fail(t, "Failed to find a pattern in the Output.", expectedOutPattern.String())
+		}
+	}
+	assert.NotContains(t, out, "Calculating Total   # 1 ... [")
+	assert.NotRegexp(t, `Calculating Total   # 1 \.\.\. \d`, out)
+	showOutputOnFailure(t, out)
+}
+
+func TestSpin(t *testing.T) {
+	renderOutput := outputWriter{}
+	pw := generateWriter()
+	pw.SetOutputWriter(&renderOutput)
+
+	err := spin(pw, "Loading config", func() error {
+		time.Sleep(time.Millisecond * 250)
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.False(t, pw.IsRenderInProgress())
+	out := renderOutput.String()
+	assert.Regexp(t, `\x1b\[KLoading config \.\.\. [⠋⠙⠹⠸⠼⠴⠦⠧⠇⠏] \[[\d.]+[µm]?s]\n`, out)
+	assert.Regexp(t, `\x1b\[KLoading config \.\.\. done! \[[\d.]+ms]\n$`, out)
+	assert.True(t, strings.Count(out, "Loading config") > 2)
+	showOutputOnFailure(t, out)
+}
+
+func TestSpin_Error(t *testing.T) {
+	renderOutput := outputWriter{}
+	pw := generateWriter()
+	pw.SetOutputWriter(&renderOutput)
+
+	err := spin(pw, "Loading config", func() error {
+		return errors.New("file not found")
+	})
+	assert.EqualError(t, err, "file not found")
+	assert.False(t, pw.IsRenderInProgress())
+	out := renderOutput.String()
+	assert.Regexp(t, `\x1b\[KLoading config \.\.\. error! \[[\d.]+[µm]?s]: file not found\n$`, out)
+	showOutputOnFailure(t, out)
+}
@@ -3,6 +3,7 @@ package progress
 import (
 	"time"
 
+	"github.com/jedib0t/go-pretty/v6/list"
 	"github.com/jedib0t/go-pretty/v6/text"
 )
 
@@ -12,6 +13,7 @@ type Style struct {
 	Chars   StyleChars   // characters to use on the progress bar
 	Colors  StyleColors  // colors to use on the progress bar
 	Options StyleOptions // misc. options for the progress bar
+	Tree    list.Style   // connectors to render the child trackers with
 }
 
 var (
@@ -21,6 +23,7 @@ var (
 		Chars:   StyleCharsDefault,
 		Colors:  StyleColorsDefault,
 		Options: StyleOptionsDefault,
+		Tree:    list.StyleConnectedLight,
 	}
 
 	// StyleBlocks uses UNICODE Block Drawing characters to render the Trackers.
@@ -29,6 +32,7 @@ var (
 		Chars:   StyleCharsBlocks,
 		Colors:  StyleColorsDefault,
 		Options: StyleOptionsDefault,
+		Tree:    list.StyleConnectedLight,
 	}
 
 	// StyleCircle uses UNICODE Circle runes to render the Trackers.
@@ -37,6 +41,7 @@ var (
 		Chars:   StyleCharsCircle,
 		Colors:  StyleColorsDefault,
 		Options: StyleOptionsDefault,
+		Tree:    list.StyleConnectedLight,
 	}
 
 	// StyleRhombus uses UNICODE Rhombus runes to render the Trackers.
@@ -45,19 +50,20 @@ var (
 		Chars:   StyleCharsRhombus,
 		Colors:  StyleColorsDefault,
 		Options: StyleOptionsDefault,
+		Tree:    list.StyleConnectedLight,
 	}
 )
 
 // StyleChars defines the characters/strings to use for rendering the Tracker.
 type StyleChars struct {
-	BoxLeft       string // left-border
-	BoxRight      string // right-border
-	Finished      string // finished block
-	Finished25    string // 25% finished block
-	Finished50    string // 50% finished block
-	Finished75    string // 75% finished block
-	Indeterminate IndeterminateIndicatorGenerator
-	Unfinished    string // 0% finished block
+	BoxLeft       string                          // left-border
+	BoxRight      string                          // right-border
+	Finished      string                          // finished block
+	Finished25    string                          // 25% finished block
+	Finished50    string                          // 50% finished block
+	Finished75    string                          // 75% finished block
+	Indeterminate IndeterminateIndicatorGenerator `json:"-"`
+	Unfinished    string                          // 0% finished block
 }
 
 var (
@@ -113,12 +119,15 @@ var (
 // StyleColors defines what colors to use for various parts of the Progress and
 // Tracker texts.
 type StyleColors struct {
-	Message text.Colors // message text colors
-	Percent text.Colors // percentage text colors
-	Stats   text.Colors // stats text (time, value) colors
-	Time    text.Colors // time text colors (overrides Stats)
-	Tracker text.Colors // tracker text colors
-	Value   text.Colors // value text colors (overrides Stats)
+	Error      text.Colors // error text colors (for errored trackers)
+	Message    text.Colors // message text colors
+	Percent    text.Colors // percentage text colors
+	Speed      text.Colors // speed text colors (overrides Stats)
+	Stats      text.Colors // stats text (time, value) colors
+	SubMessage text.Colors // sub-message text colors
+	Time       text.Colors // time text colors (overrides Stats)
+	Tracker    text.Colors // tracker text colors
+	Value      text.Colors // value text colors (overrides Stats)
 }
 
 var (
@@ -128,23 +137,30 @@ var (
 	// StyleColorsExample defines a few choice color options. Use this is just
 	// as an example to customize the Tracker/text colors.
 	StyleColorsExample = StyleColors{
-		Message: text.Colors{text.FgWhite},
-		Percent: text.Colors{text.FgHiRed},
-		Stats:   text.Colors{text.FgHiBlack},
-		Time:    text.Colors{text.FgGreen},
-		Tracker: text.Colors{text.FgYellow},
-		Value:   text.Colors{text.FgCyan},
+		Error:      text.Colors{text.FgRed},
+		Message:    text.Colors{text.FgWhite},
+		Percent:    text.Colors{text.FgHiRed},
+		Speed:      text.Colors{text.FgBlue},
+		Stats:      text.Colors{text.FgHiBlack},
+		SubMessage: text.Colors{text.FgHiBlack, text.Italic},
+		Time:       text.Colors{text.FgGreen},
+		Tracker:    text.Colors{text.FgYellow},
+		Value:      text.Colors{text.FgCyan},
 	}
 )
 
 // StyleOptions defines misc. options to control how the Tracker or its parts
 // gets rendered.
 type StyleOptions struct {
+	CancelledString         string        // "cancelled!" string
 	DoneString              string        // "done!" string
+	ErrorString             string        // "error!" string
 	ETAPrecision            time.Duration // precision for ETA
 	ETAString               string        // string for ETA
+	Layout                  string        // template for the Tracker line (see LayoutFields)
 	Separator               string        // text between message and tracker
 	SnipIndicator           string        // text denoting message snipping
+	SpeedSuffix             string        // text after the speed (per second)
 	PercentFormat           string        // formatting to use for percentage
 	PercentIndeterminate    string        // when percentage cannot be computed
 	TimeDonePrecision       time.Duration // precision for time when done
@@ -156,13 +172,16 @@ var (
 	// StyleOptionsDefault defines sane defaults for the Options. Use this as an
 	// example to customize the Tracker rendering.
 	StyleOptionsDefault = StyleOptions{
+		CancelledString:         "cancelled!",
 		DoneString:              "done!",
+		ErrorString:             "error!",
 		ETAPrecision:            time.Second,
 		ETAString:               "~ETA",
 		PercentFormat:           "%5.2f%%",
 		PercentIndeterminate:    " ??? ",
 		Separator:               " ... ",
 		SnipIndicator:           "~",
+		SpeedSuffix:             "/s",
 		TimeDonePrecision:       time.Millisecond,
 		TimeInProgressPrecision: time.Microsecond,
 		TimeOverallPrecision:    time.Second,
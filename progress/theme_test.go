@@ -0,0 +1,82 @@
+package progress
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegisterStyle(t *testing.T) {
+	defer stylesRegistry.Unregister("StyleTest")
+
+	assert.EqualError(t, RegisterStyle(Style{}), "cannot register a style without a name")
+
+	style := StyleCircle
+	style.Name = "StyleTest"
+	assert.Nil(t, RegisterStyle(style))
+	styleRegistered, ok := StyleByName("test")
+	assert.True(t, ok)
+	assert.Equal(t, style.Chars.Finished, styleRegistered.Chars.Finished)
+	assert.Contains(t, StyleNames(), "StyleTest")
+}
+
+func TestStyleByName(t *testing.T) {
+	for _, name := range []string{"StyleBlocks", "blocks", "BLOCKS"} {
+		style, ok := StyleByName(name)
+		assert.True(t, ok, name)
+		assert.Equal(t, StyleBlocks.Name, style.Name, name)
+		assert.Equal(t, StyleBlocks.Chars.Finished, style.Chars.Finished, name)
+	}
+
+	_, ok := StyleByName("foo")
+	assert.False(t, ok)
+}
+
+func TestStyleFromJSON(t *testing.T) {
+	style, err := StyleFromJSON([]byte(`{
+		"Name": "MyStyle",
+		"Chars": {"BoxLeft": "|", "BoxRight": "|", "Finished": "="},
+		"Colors": {"Percent": ["fg-hi-red"], "Tracker": ["fg-256-208"]},
+		"Options": {"DoneString": "OK", "TimeDonePrecision": 1000000000}
+	}`))
+	assert.Nil(t, err)
+	assert.Equal(t, "MyStyle", style.Name)
+	assert.Equal(t, "|", style.Chars.BoxLeft)
+	assert.Equal(t, "|", style.Chars.BoxRight)
+	assert.Equal(t, "=", style.Chars.Finished)
+	assert.Equal(t, StyleDefault.Chars.Unfinished, style.Chars.Unfinished)
+	assert.NotNil(t, style.Chars.Indeterminate)
+	assert.Equal(t, text.Colors{text.FgHiRed}, style.Colors.Percent)
+	assert.Equal(t, text.Colors{text.Fg256(208)}, style.Colors.Tracker)
+	assert.Nil(t, style.Colors.Message)
+	assert.Equal(t, "OK", style.Options.DoneString)
+	assert.Equal(t, time.Second, style.Options.TimeDonePrecision)
+	assert.Equal(t, StyleDefault.Options.PercentFormat, style.Options.PercentFormat)
+
+	_, err = StyleFromJSON([]byte(`{"Colors": {"Percent": ["foo"]}}`))
+	assert.EqualError(t, err, `failed to parse style: invalid color "foo"`)
+}
+
+func TestStyleNames(t *testing.T) {
+	assert.Equal(t, []string{"StyleBlocks", "StyleCircle", "StyleDefault", "StyleRhombus"}, StyleNames())
+}
+
+func TestStyle_JSON(t *testing.T) {
+	style := StyleBlocks
+	style.Colors = StyleColorsExample
+	data, err := style.JSON()
+	assert.Nil(t, err)
+	assert.Contains(t, string(data), `"Finished": "█",`)
+	assert.Contains(t, string(data), `"Percent": [
+      "fg-hi-red"
+    ],`)
+	assert.NotContains(t, string(data), `"Indeterminate"`)
+
+	styleUnmarshaled, err := StyleFromJSON(data)
+	assert.Nil(t, err)
+	assert.Equal(t, style.Chars.Finished, styleUnmarshaled.Chars.Finished)
+	assert.Equal(t, style.Colors, styleUnmarshaled.Colors)
+	assert.Equal(t, style.Options, styleUnmarshaled.Options)
+}
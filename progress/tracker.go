@@ -1,17 +1,33 @@
 package progress
 
 import (
+	"fmt"
+	"math"
 	"sync"
 	"time"
 )
 
+var (
+	// speedSampleInterval is the minimum time between two samples of the rate
+	// of progress used to compute the speed of a Tracker
+	speedSampleInterval = time.Millisecond * 100
+	// speedWindow is the time window over which the samples are averaged; the
+	// weight of a sample decays exponentially with its age relative to this
+	speedWindow = time.Second * 5
+)
+
 // Tracker helps track the progress of a single task. The way to use it is to
 // instantiate a Tracker with a valid Message, a valid (expected) Total, and
 // Units values. This should then be fed to the Progress Writer with the
 // Writer.AppendTracker() method. When the task that is being done has progress,
 // increment the value using the Tracker.Increment(value) method.
+//
+// A Tracker may have child Trackers (see AppendChild) for the sub-tasks of the
+// task; the progress of the children rolls up into the parent, which gets
+// rendered with the children indented below it.
 type Tracker struct {
-	// Message should contain a short description of the "task"
+	// Message should contain a short description of the "task"; use
+	// UpdateMessage to change it once the Tracker is being rendered
 	Message string
 	// ExpectedDuration tells how long this task is expected to take; and will
 	// be used in calculation of the ETA value
@@ -20,27 +36,111 @@ type Tracker struct {
 	Total int64
 	// Units defines the type of the "value" being tracked
 	Units Units
+	// Spinner (if set) renders a spinner with these frames instead of the
+	// progress bar and the percentage, for tasks that just need to show that
+	// they are in progress
+	Spinner Spinner
+	// Weight defines the share of this Tracker in the progress of its parent
+	// (if any); children without a Weight get a Weight of 1, and if none of
+	// the children have a Weight, they are weighted by their Total instead
+	Weight int64
 
-	done      bool
-	mutex     sync.RWMutex
-	mutexPrv  sync.RWMutex
-	timeStart time.Time
-	timeStop  time.Time
-	value     int64
+	cancelled     bool
+	children      []*Tracker
+	done          bool
+	err           error
+	errored       bool
+	metadata      map[string]interface{}
+	metadataKeys  []string
+	mutex         sync.RWMutex
+	mutexChildren sync.RWMutex
+	mutexPrv      sync.RWMutex
+	parent        *Tracker
+	speed         float64
+	speedSampled  bool
+	speedTime     time.Time
+	speedValue    int64
+	subMessage    string
+	timeStart     time.Time
+	timeStop      time.Time
+	value         int64
 }
 
-// ETA returns the expected time of "arrival" or completion of this tracker. It
-// is an estimate and is not guaranteed.
-func (t *Tracker) ETA() time.Duration {
+// AppendChild appends a child Tracker for a sub-task of this Tracker. Once a
+// Tracker has children, its Total and value are computed from those of the
+// children, and it is done once all the children are done; it is marked as
+// errored if any of the children errored. Children that errored or got
+// cancelled add only the progress they made to the value of the parent.
+//
+// If none of the children have a Weight, the Total and value of the parent are
+// the sum of those of the children (and so the parent can be given the same
+// Units as the children). Otherwise, like the overall tracker of the Progress Writer,
+// the parent tracks the percentage done of each child scaled by its Weight.
+//
+// Only the parent Tracker needs to be appended to the Progress Writer, and the
+// children should be appended before any of them are done.
+func (t *Tracker) AppendChild(child *Tracker) {
+	t.mutexChildren.Lock()
+	child.mutex.Lock()
+	child.parent = t
+	child.mutex.Unlock()
+	t.children = append(t.children, child)
+	t.mutexChildren.Unlock()
+
+	t.mutexPrv.RLock()
+	started := !t.timeStart.IsZero()
+	t.mutexPrv.RUnlock()
+	if started {
+		child.startIfNotStarted()
+	}
+	t.updateFromChildren()
+}
+
+// AppendChildren appends one or more child Trackers.
+func (t *Tracker) AppendChildren(children []*Tracker) {
+	for _, child := range children {
+		t.AppendChild(child)
+	}
+}
+
+// Children returns the child Trackers appended using AppendChild.
+func (t *Tracker) Children() []*Tracker {
+	t.mutexChildren.RLock()
+	defer t.mutexChildren.RUnlock()
+
+	return append([]*Tracker(nil), t.children...)
+}
+
+// Err returns the error the tracker was marked as errored with (if any).
+func (t *Tracker) Err() error {
 	t.mutex.RLock()
 	defer t.mutex.RUnlock()
 
+	return t.err
+}
+
+// ETA returns the expected time of "arrival" or completion of this tracker. It
+// is an estimate and is not guaranteed. It is computed using the smoothed rate
+// of progress (see Speed) once it is known, and by extrapolating the progress
+// made so far until then.
+func (t *Tracker) ETA() time.Duration {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
 	timeTaken := time.Since(t.timeStart)
 	if t.ExpectedDuration > time.Duration(0) && t.ExpectedDuration > timeTaken {
 		return t.ExpectedDuration - timeTaken
 	}
+	if t.Total == 0 || t.value >= t.Total {
+		return time.Duration(0)
+	}
+
+	t.updateSpeedWithoutLock(time.Now())
+	if t.speedSampled && t.speed > 0 {
+		return time.Duration(float64(t.Total-t.value) / t.speed * float64(time.Second))
+	}
 
-	pDone := int64(t.PercentDone())
+	pDone := t.value * 100 / t.Total
 	if pDone == 0 {
 		return time.Duration(0)
 	}
@@ -52,10 +152,12 @@ func (t *Tracker) Increment(value int64) {
 	t.mutex.Lock()
 	t.incrementWithoutLock(value)
 	t.mutex.Unlock()
+	t.notifyParent()
 }
 
 // IsDone returns true if the tracker is done (value has reached the expected
-// Total set during initialization).
+// Total set during initialization), or if it was marked as done, errored or
+// cancelled.
 func (t *Tracker) IsDone() bool {
 	t.mutex.RLock()
 	defer t.mutex.RUnlock()
@@ -63,6 +165,22 @@ func (t *Tracker) IsDone() bool {
 	return t.done
 }
 
+// IsCancelled returns true if the tracker was stopped using MarkAsCancelled.
+func (t *Tracker) IsCancelled() bool {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	return t.cancelled
+}
+
+// IsErrored returns true if the tracker was stopped using MarkAsErrored.
+func (t *Tracker) IsErrored() bool {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	return t.errored
+}
+
 // IsIndeterminate returns true if the tracker is indeterminate; i.e., the total
 // is unknown and it is impossible to auto-calculate if tracking is done.
 func (t *Tracker) IsIndeterminate() bool {
@@ -79,6 +197,55 @@ func (t *Tracker) MarkAsDone() {
 	t.Total = t.value
 	t.stop()
 	t.mutex.Unlock()
+	t.notifyParent()
+}
+
+// MarkAsCancelled stops the tracker (without updating the current value) to
+// denote that the task was cancelled before completion. The tracker is rendered
+// with StyleOptions.CancelledString instead of StyleOptions.DoneString.
+func (t *Tracker) MarkAsCancelled() {
+	t.mutex.Lock()
+	if !t.done {
+		t.cancelled = true
+		t.stop()
+	}
+	t.mutex.Unlock()
+	t.notifyParent()
+}
+
+// MarkAsErrored stops the tracker (without updating the current value) to
+// denote that the task failed with the given error. The tracker is rendered
+// with StyleOptions.ErrorString and the error message instead of
+// StyleOptions.DoneString.
+func (t *Tracker) MarkAsErrored(err error) {
+	t.mutex.Lock()
+	if !t.done {
+		t.err = err
+		t.errored = true
+		t.stop()
+	}
+	t.mutex.Unlock()
+	t.notifyParent()
+}
+
+// Metadata returns (a copy of) the key/value pairs set using SetMetadata.
+func (t *Tracker) Metadata() map[string]interface{} {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	metadata := make(map[string]interface{}, len(t.metadata))
+	for key, value := range t.metadata {
+		metadata[key] = value
+	}
+	return metadata
+}
+
+// Parent returns the Tracker this Tracker was appended to as a child (if any).
+func (t *Tracker) Parent() *Tracker {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	return t.parent
 }
 
 // PercentDone returns the currently completed percentage value.
@@ -95,22 +262,110 @@ func (t *Tracker) PercentDone() float64 {
 // Reset resets the tracker to its initial state.
 func (t *Tracker) Reset() {
 	t.mutex.Lock()
+	t.cancelled = false
 	t.done = false
+	t.err = nil
+	t.errored = false
+	t.speed = 0
+	t.speedSampled = false
+	t.speedTime = time.Time{}
+	t.speedValue = 0
 	t.timeStart = time.Time{}
 	t.timeStop = time.Time{}
 	t.value = 0
 	t.mutex.Unlock()
+	t.notifyParent()
+}
+
+// SetMetadata sets a key/value pair that gets rendered with the stats of the
+// tracker (in the order in which the keys were first set) like "key=value";
+// setting a nil value removes the key. For ex.:
+//  tracker.SetMetadata("host", "web-01")
+//  tracker.SetMetadata("retries", 2)
+func (t *Tracker) SetMetadata(key string, value interface{}) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if value == nil {
+		if _, ok := t.metadata[key]; ok {
+			delete(t.metadata, key)
+			for idx, metadataKey := range t.metadataKeys {
+				if metadataKey == key {
+					t.metadataKeys = append(t.metadataKeys[:idx], t.metadataKeys[idx+1:]...)
+					break
+				}
+			}
+		}
+		return
+	}
+	if t.metadata == nil {
+		t.metadata = make(map[string]interface{})
+	}
+	if _, ok := t.metadata[key]; !ok {
+		t.metadataKeys = append(t.metadataKeys, key)
+	}
+	t.metadata[key] = value
 }
 
 // SetValue sets the value of the tracker and re-calculates if the tracker is
 // "done".
 func (t *Tracker) SetValue(value int64) {
 	t.mutex.Lock()
+	t.cancelled = false
 	t.done = false
+	t.err = nil
+	t.errored = false
 	t.timeStop = time.Time{}
 	t.value = 0
+	// start measuring the speed afresh from the new value
+	t.speedTime = time.Time{}
 	t.incrementWithoutLock(value)
 	t.mutex.Unlock()
+	t.notifyParent()
+}
+
+// Speed returns the rate of progress of the tracker in Units per second. While
+// the tracker is in progress, this is an exponentially weighted moving average
+// of the rate over the last few seconds, and so it doesn't fluctuate much
+// with bursts of progress. Once the tracker is done, this is the average rate
+// over the whole duration of the task.
+func (t *Tracker) Speed() float64 {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if t.done {
+		if timeTaken := t.timeStop.Sub(t.timeStart); !t.timeStart.IsZero() && timeTaken > 0 {
+			return float64(t.value) / timeTaken.Seconds()
+		}
+		return t.speed
+	}
+	t.updateSpeedWithoutLock(time.Now())
+	return t.speed
+}
+
+// SubMessage returns the sub-message set using UpdateSubMessage.
+func (t *Tracker) SubMessage() string {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	return t.subMessage
+}
+
+// UpdateMessage updates the Message of the tracker. Unlike setting the Message
+// directly, this is safe to do while the tracker is being rendered.
+func (t *Tracker) UpdateMessage(message string) {
+	t.mutex.Lock()
+	t.Message = message
+	t.mutex.Unlock()
+}
+
+// UpdateSubMessage updates the sub-message of the tracker, which gets rendered
+// on a line of its own below the tracker while it is in progress, like the
+// file currently being processed. An empty sub-message removes the line.
+func (t *Tracker) UpdateSubMessage(subMessage string) {
+	t.mutex.Lock()
+	t.subMessage = subMessage
+	t.mutex.Unlock()
 }
 
 // Value returns the current value of the tracker.
@@ -120,9 +375,31 @@ func (t *Tracker) Value() int64 {
 	return t.value
 }
 
+// message returns the Message of the tracker.
+func (t *Tracker) message() string {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	return t.Message
+}
+
+// metadataPairs returns the metadata as "key=value" strings, in the order in
+// which the keys were first set.
+func (t *Tracker) metadataPairs() []string {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	pairs := make([]string, 0, len(t.metadataKeys))
+	for _, key := range t.metadataKeys {
+		pairs = append(pairs, fmt.Sprintf("%s=%v", key, t.metadata[key]))
+	}
+	return pairs
+}
+
 func (t *Tracker) incrementWithoutLock(value int64) {
 	if !t.done {
 		t.value += value
+		t.updateSpeedWithoutLock(time.Now())
 		if t.Total > 0 && t.value >= t.Total {
 			t.stop()
 		}
@@ -130,10 +407,55 @@ func (t *Tracker) incrementWithoutLock(value int64) {
 }
 
 func (t *Tracker) start() {
+	// the value and the speed sample are guarded by mutex (like in Increment)
+	t.mutex.Lock()
 	t.mutexPrv.Lock()
 	t.done = false
 	t.timeStart = time.Now()
+	t.speedTime = t.timeStart
+	t.speedValue = t.value
 	t.mutexPrv.Unlock()
+	t.mutex.Unlock()
+
+	for _, child := range t.Children() {
+		child.startIfNotStarted()
+	}
+}
+
+// startIfNotStarted starts a child Tracker along with its parent, unless it
+// was started (or is done) already.
+func (t *Tracker) startIfNotStarted() {
+	t.mutex.RLock()
+	t.mutexPrv.RLock()
+	started := t.done || !t.timeStart.IsZero()
+	t.mutexPrv.RUnlock()
+	t.mutex.RUnlock()
+	if !started {
+		t.start()
+	}
+}
+
+// updateSpeedWithoutLock samples the rate of progress since the previous
+// sample (if enough time has passed since then) and folds it into the moving
+// average.
+func (t *Tracker) updateSpeedWithoutLock(now time.Time) {
+	if t.speedTime.IsZero() {
+		t.speedTime, t.speedValue = now, t.value
+		return
+	}
+	timeTaken := now.Sub(t.speedTime)
+	if timeTaken < speedSampleInterval {
+		return
+	}
+
+	speed := float64(t.value-t.speedValue) / timeTaken.Seconds()
+	if t.speedSampled {
+		weight := 1 - math.Exp(-timeTaken.Seconds()/speedWindow.Seconds())
+		t.speed += weight * (speed - t.speed)
+	} else {
+		t.speed, t.speedSampled = speed, true
+	}
+	t.speedTime, t.speedValue = now, t.value
 }
 
 func (t *Tracker) stop() {
@@ -145,3 +467,72 @@ func (t *Tracker) stop() {
 	}
 	t.mutexPrv.Unlock()
 }
+
+// notifyParent updates the parent (if any) with the progress of its children,
+// and has to be called without holding any of the locks.
+func (t *Tracker) notifyParent() {
+	if parent := t.Parent(); parent != nil {
+		parent.updateFromChildren()
+	}
+}
+
+// updateFromChildren rolls up the progress of the children into the Tracker.
+func (t *Tracker) updateFromChildren() {
+	t.mutexChildren.Lock()
+	if len(t.children) == 0 {
+		t.mutexChildren.Unlock()
+		return
+	}
+	weighted := false
+	for _, child := range t.children {
+		child.mutex.RLock()
+		weighted = weighted || child.Weight > 0
+		child.mutex.RUnlock()
+	}
+
+	var total int64
+	var value float64
+	var numDone, numErrored int
+	for _, child := range t.children {
+		child.mutex.RLock()
+		weight := child.Total
+		if weighted {
+			weight = 100
+			if child.Weight > 0 {
+				weight = child.Weight * 100
+			}
+		}
+		total += weight
+		if child.done {
+			numDone++
+			if child.errored {
+				numErrored++
+			}
+		}
+		// children that errored or got cancelled count only with the progress
+		// they made before they stopped
+		if child.done && !child.errored && !child.cancelled {
+			value += float64(weight)
+		} else if child.Total > 0 {
+			value += float64(weight) * math.Min(float64(child.value)/float64(child.Total), 1)
+		}
+		child.mutex.RUnlock()
+	}
+	numChildren := len(t.children)
+	t.mutexChildren.Unlock()
+
+	t.mutex.Lock()
+	if !t.done {
+		t.Total, t.value = total, int64(math.Round(value))
+		t.updateSpeedWithoutLock(time.Now())
+		if numDone == numChildren {
+			if numErrored > 0 {
+				t.err = fmt.Errorf("%d of %d trackers failed", numErrored, numChildren)
+				t.errored = true
+			}
+			t.stop()
+		}
+	}
+	t.mutex.Unlock()
+	t.notifyParent()
+}
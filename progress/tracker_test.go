@@ -1,12 +1,135 @@
 package progress
 
 import (
+	"errors"
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 )
 
+func TestTracker_AppendChild(t *testing.T) {
+	parent := &Tracker{Message: "Deploying"}
+	child1 := &Tracker{Message: "Uploading to host-1", Total: 300}
+	child2 := &Tracker{Message: "Uploading to host-2", Total: 100}
+	parent.AppendChild(child1)
+	parent.AppendChildren([]*Tracker{child2})
+	assert.Equal(t, []*Tracker{child1, child2}, parent.Children())
+	assert.Equal(t, parent, child1.Parent())
+	assert.Equal(t, parent, child2.Parent())
+	assert.Nil(t, parent.Parent())
+	assert.Equal(t, int64(400), parent.Total)
+	assert.Equal(t, int64(0), parent.Value())
+
+	// weighted by the Total
+	child1.Increment(150)
+	assert.Equal(t, int64(150), parent.Value())
+	assert.Equal(t, 37.5, parent.PercentDone())
+	child2.Increment(100)
+	assert.Equal(t, int64(250), parent.Value())
+	assert.True(t, child2.IsDone())
+	assert.False(t, parent.IsDone())
+
+	child1.Increment(150)
+	assert.Equal(t, int64(400), parent.Value())
+	assert.True(t, parent.IsDone())
+	assert.False(t, parent.IsErrored())
+}
+
+func TestTracker_AppendChild_Nested(t *testing.T) {
+	root := &Tracker{Message: "Release"}
+	parent := &Tracker{Message: "Deploying", Weight: 3}
+	child := &Tracker{Message: "Uploading", Total: 10}
+	sibling := &Tracker{Message: "Tagging", Total: 5, Weight: 1}
+	parent.AppendChild(child)
+	root.AppendChildren([]*Tracker{parent, sibling})
+	assert.Equal(t, int64(400), root.Total)
+
+	// weighted by the Weight
+	child.Increment(5)
+	assert.Equal(t, int64(5), parent.Value())
+	assert.Equal(t, int64(150), root.Value())
+	assert.Equal(t, 37.5, root.PercentDone())
+
+	// an errored child adds only the progress it made
+	sibling.Increment(2)
+	sibling.MarkAsErrored(errors.New("tag exists"))
+	assert.Equal(t, int64(190), root.Value())
+	assert.Equal(t, 47.5, root.PercentDone())
+	assert.False(t, root.IsDone())
+
+	child.MarkAsDone()
+	assert.True(t, parent.IsDone())
+	assert.False(t, parent.IsErrored())
+	assert.True(t, root.IsDone())
+	assert.True(t, root.IsErrored())
+	assert.EqualError(t, root.Err(), "1 of 2 trackers failed")
+	assert.Equal(t, int64(340), root.Value())
+	assert.Equal(t, 85.0, root.PercentDone())
+}
+
+func TestTracker_AppendChild_Cancelled(t *testing.T) {
+	parent := &Tracker{}
+	child1 := &Tracker{Total: 100}
+	child2 := &Tracker{Total: 300}
+	child3 := &Tracker{}
+	parent.AppendChildren([]*Tracker{child1, child2, child3})
+
+	// a cancelled child adds only the progress it made
+	child1.Increment(100)
+	child2.Increment(60)
+	child2.MarkAsCancelled()
+	assert.Equal(t, int64(160), parent.Value())
+	assert.False(t, parent.IsDone())
+
+	// as does an errored child without a Total
+	child3.MarkAsErrored(errors.New("timed out"))
+	assert.True(t, parent.IsDone())
+	assert.True(t, parent.IsErrored())
+	assert.Equal(t, int64(160), parent.Value())
+	assert.Equal(t, int64(400), parent.Total)
+	assert.Equal(t, 40.0, parent.PercentDone())
+}
+
+func TestTracker_AppendChild_Weight(t *testing.T) {
+	parent := &Tracker{}
+	child1 := &Tracker{Total: 1000, Weight: 2}
+	child2 := &Tracker{Total: 10}
+	parent.AppendChildren([]*Tracker{child1, child2})
+	assert.Equal(t, int64(300), parent.Total)
+
+	child2.Increment(5)
+	assert.Equal(t, int64(50), parent.Value())
+	child1.Increment(1)
+	assert.Equal(t, int64(50), parent.Value())
+	child1.Increment(499)
+	assert.Equal(t, int64(150), parent.Value())
+	assert.Equal(t, 50.0, parent.PercentDone())
+}
+
+func TestTracker_AppendChild_Started(t *testing.T) {
+	parent := &Tracker{}
+	child1 := &Tracker{Total: 10}
+	parent.AppendChild(child1)
+	assert.True(t, child1.timeStart.IsZero())
+
+	parent.start()
+	assert.False(t, child1.timeStart.IsZero())
+
+	child2 := &Tracker{Total: 10}
+	parent.AppendChild(child2)
+	assert.False(t, child2.timeStart.IsZero())
+}
+
+func TestTracker_Err(t *testing.T) {
+	tracker := Tracker{Total: 100}
+	assert.Nil(t, tracker.Err())
+
+	tracker.MarkAsErrored(errors.New("connection reset"))
+	assert.EqualError(t, tracker.Err(), "connection reset")
+}
+
 func TestTracker_ETA(t *testing.T) {
 	timeDelayUnit := time.Millisecond
 	timeDelay := timeDelayUnit * 25
@@ -33,6 +156,24 @@ func TestTracker_ETA(t *testing.T) {
 	assert.Equal(t, time.Duration(0), tracker.ETA())
 }
 
+func TestTracker_ETA_Smoothed(t *testing.T) {
+	tracker := Tracker{Total: 1000}
+	tracker.start()
+	now := tracker.speedTime
+
+	// 10 units every 100ms => 100 units/sec; 900 units left => 9s
+	for idx := 1; idx <= 10; idx++ {
+		tracker.value += 10
+		tracker.updateSpeedWithoutLock(now.Add(speedSampleInterval * time.Duration(idx)))
+	}
+	assert.Equal(t, time.Second*9, tracker.ETA().Round(time.Second))
+
+	// a burst of progress does not make the ETA jump
+	tracker.value += 100
+	tracker.updateSpeedWithoutLock(now.Add(speedSampleInterval * 11))
+	assert.Equal(t, time.Second*7, tracker.ETA().Round(time.Second))
+}
+
 func TestTracker_Increment(t *testing.T) {
 	tracker := Tracker{Total: 100}
 	assert.Equal(t, int64(0), tracker.value)
@@ -75,6 +216,69 @@ func TestTracker_MarkAsDone(t *testing.T) {
 	assert.False(t, tracker.timeStop.IsZero())
 }
 
+func TestTracker_MarkAsCancelled(t *testing.T) {
+	tracker := Tracker{Total: 100}
+	tracker.Increment(25)
+	assert.False(t, tracker.IsCancelled())
+	assert.False(t, tracker.IsDone())
+
+	tracker.MarkAsCancelled()
+	assert.True(t, tracker.IsCancelled())
+	assert.True(t, tracker.IsDone())
+	assert.False(t, tracker.IsErrored())
+	assert.Nil(t, tracker.Err())
+	assert.False(t, tracker.timeStop.IsZero())
+	assert.Equal(t, int64(100), tracker.Total)
+	assert.Equal(t, 25.0, tracker.PercentDone())
+
+	// increments after being cancelled are ignored
+	tracker.Increment(25)
+	assert.Equal(t, int64(25), tracker.Value())
+}
+
+func TestTracker_MarkAsErrored(t *testing.T) {
+	tracker := Tracker{Total: 100}
+	tracker.Increment(25)
+	assert.False(t, tracker.IsErrored())
+	assert.False(t, tracker.IsDone())
+
+	tracker.MarkAsErrored(errors.New("connection reset"))
+	assert.True(t, tracker.IsErrored())
+	assert.True(t, tracker.IsDone())
+	assert.False(t, tracker.IsCancelled())
+	assert.False(t, tracker.timeStop.IsZero())
+	assert.Equal(t, 25.0, tracker.PercentDone())
+
+	// a tracker that is already done cannot fail
+	tracker = Tracker{Total: 100}
+	tracker.Increment(100)
+	tracker.MarkAsErrored(errors.New("connection reset"))
+	assert.False(t, tracker.IsErrored())
+	assert.Nil(t, tracker.Err())
+}
+
+func TestTracker_Metadata(t *testing.T) {
+	tracker := Tracker{}
+	assert.Empty(t, tracker.Metadata())
+	assert.Empty(t, tracker.metadataPairs())
+
+	tracker.SetMetadata("host", "web-01")
+	tracker.SetMetadata("retries", 1)
+	tracker.SetMetadata("region", "us-east")
+	tracker.SetMetadata("retries", 2)
+	assert.Equal(t, map[string]interface{}{"host": "web-01", "region": "us-east", "retries": 2}, tracker.Metadata())
+	assert.Equal(t, []string{"host=web-01", "retries=2", "region=us-east"}, tracker.metadataPairs())
+
+	// the metadata returned is a copy
+	tracker.Metadata()["host"] = "web-02"
+	assert.Equal(t, "web-01", tracker.Metadata()["host"])
+
+	tracker.SetMetadata("retries", nil)
+	tracker.SetMetadata("unknown", nil)
+	assert.Equal(t, map[string]interface{}{"host": "web-01", "region": "us-east"}, tracker.Metadata())
+	assert.Equal(t, []string{"host=web-01", "region=us-east"}, tracker.metadataPairs())
+}
+
 func TestTracker_PercentDone(t *testing.T) {
 	tracker := Tracker{}
 	assert.Equal(t, 0.00, tracker.PercentDone())
@@ -103,8 +307,14 @@ func TestTracker_Reset(t *testing.T) {
 	assert.NotEqual(t, time.Time{}, tracker.timeStop)
 	assert.Equal(t, tracker.Total, tracker.value)
 
+	tracker.Reset()
+	tracker.MarkAsErrored(errors.New("connection reset"))
+	assert.True(t, tracker.IsErrored())
+
 	tracker.Reset()
 	assert.False(t, tracker.done)
+	assert.False(t, tracker.IsErrored())
+	assert.Nil(t, tracker.Err())
 	assert.Equal(t, time.Time{}, tracker.timeStart)
 	assert.Equal(t, time.Time{}, tracker.timeStop)
 	assert.Equal(t, int64(0), tracker.value)
@@ -124,6 +334,77 @@ func TestTracker_SetValue(t *testing.T) {
 	assert.True(t, tracker.done)
 }
 
+func TestTracker_Speed(t *testing.T) {
+	tracker := Tracker{Total: 1000}
+	assert.Equal(t, 0.0, tracker.Speed())
+
+	tracker.start()
+	now := tracker.speedTime
+	tracker.value = 5
+	tracker.updateSpeedWithoutLock(now.Add(speedSampleInterval / 2))
+	assert.Equal(t, 0.0, tracker.speed, "should not sample too soon")
+	assert.False(t, tracker.speedSampled)
+
+	// the first sample is used as is
+	tracker.value = 10
+	tracker.updateSpeedWithoutLock(now.Add(speedSampleInterval))
+	assert.InDelta(t, 100.0, tracker.speed, 0.001)
+	assert.True(t, tracker.speedSampled)
+
+	// the next samples are averaged with the previous ones
+	tracker.value = 40
+	tracker.updateSpeedWithoutLock(now.Add(speedSampleInterval * 2))
+	assert.True(t, tracker.speed > 100.0)
+	assert.True(t, tracker.speed < 300.0)
+
+	// a stalled tracker slows down gradually
+	speed := tracker.speed
+	tracker.updateSpeedWithoutLock(now.Add(speedSampleInterval * 3))
+	assert.True(t, tracker.speed > 0.0)
+	assert.True(t, tracker.speed < speed)
+
+	// once done, the speed is the average over the whole duration
+	tracker.timeStart = now
+	tracker.value = 1000
+	tracker.stop()
+	tracker.timeStop = now.Add(time.Second * 4)
+	assert.Equal(t, 250.0, tracker.Speed())
+
+	tracker.Reset()
+	assert.Equal(t, 0.0, tracker.speed)
+	assert.False(t, tracker.speedSampled)
+}
+
+func TestTracker_UpdateMessage(t *testing.T) {
+	tracker := Tracker{Message: "Downloading"}
+	assert.Equal(t, "Downloading", tracker.message())
+
+	done := make(chan bool)
+	go func() {
+		for idx := 0; idx < 100; idx++ {
+			tracker.UpdateMessage(fmt.Sprintf("Downloading File # %d", idx))
+		}
+		close(done)
+	}()
+	for idx := 0; idx < 100; idx++ {
+		assert.Contains(t, tracker.message(), "Downloading")
+	}
+	<-done
+	assert.Equal(t, "Downloading File # 99", tracker.message())
+}
+
+func TestTracker_UpdateSubMessage(t *testing.T) {
+	tracker := Tracker{Message: "Downloading"}
+	assert.Equal(t, "", tracker.SubMessage())
+
+	tracker.UpdateSubMessage("foo.txt")
+	assert.Equal(t, "foo.txt", tracker.SubMessage())
+	assert.Equal(t, "Downloading", tracker.message())
+
+	tracker.UpdateSubMessage("")
+	assert.Equal(t, "", tracker.SubMessage())
+}
+
 func TestTracker_Value(t *testing.T) {
 	tracker := Tracker{}
 	assert.Equal(t, int64(0), tracker.value)
@@ -3,6 +3,8 @@ package progress
 import (
 	"io"
 	"time"
+
+	"github.com/jedib0t/go-pretty/v6/text"
 )
 
 // Writer declares the interfaces that can be used to setup and render a
@@ -14,18 +16,24 @@ type Writer interface {
 	Length() int
 	LengthActive() int
 	LengthDone() int
+	LengthErrored() int
 	LengthInQueue() int
 	SetAutoStop(autoStop bool)
+	SetColorProfile(profile text.ColorProfile)
+	SetLogInterval(interval time.Duration)
 	SetMessageWidth(width int)
 	SetNumTrackersExpected(numTrackers int)
 	SetOutputWriter(output io.Writer)
+	SetRenderMode(mode RenderMode)
 	SetSortBy(sortBy SortBy)
 	SetStyle(style Style)
 	SetTrackerLength(length int)
 	SetTrackerPosition(position Position)
+	ShowDoneChildren(show bool)
 	ShowETA(show bool)
 	ShowOverallTracker(show bool)
 	ShowPercentage(show bool)
+	ShowSpeed(show bool)
 	ShowTime(show bool)
 	ShowTracker(show bool)
 	ShowValue(show bool)
@@ -0,0 +1,158 @@
+package table
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/jedib0t/go-pretty/v6/text"
+)
+
+// ColorRule is a custom function that determines the colors to use on a cell
+// based on its raw (un-transformed) value and on the statistics of all the raw
+// values in the same column. It should return nil if the cell should not be
+// colored by this rule.
+//
+// Refer to the ColorRule* functions for ready-to-use rules.
+type ColorRule func(val interface{}, stats ColumnStats) text.Colors
+
+// ColumnStats contains statistics about the raw values of all the regular rows
+// in a column. It is computed once before rendering and passed on to every
+// ColorRule invocation.
+type ColumnStats struct {
+	// Max is the largest numeric value in the column
+	Max float64
+	// Min is the smallest numeric value in the column
+	Min float64
+	// NumNumbers is the number of numeric values in the column; NaN and
+	// infinite values are not counted (nor considered for Max and Min)
+	NumNumbers int
+	// Occurrences contains the number of times each value (in string form)
+	// occurs in the column
+	Occurrences map[string]int
+}
+
+// newColumnStats computes the statistics for the given column in the rows.
+func newColumnStats(rows []Row, colIdx int) ColumnStats {
+	stats := ColumnStats{
+		Max:         math.Inf(-1),
+		Min:         math.Inf(1),
+		Occurrences: make(map[string]int),
+	}
+	for _, row := range rows {
+		if colIdx >= len(row) {
+			continue
+		}
+		stats.Occurrences[fmt.Sprint(row[colIdx])]++
+		if number, ok := toFloat64(row[colIdx]); ok && isFinite(number) {
+			stats.Max = math.Max(stats.Max, number)
+			stats.Min = math.Min(stats.Min, number)
+			stats.NumNumbers++
+		}
+	}
+	return stats
+}
+
+// getDataBarFraction returns the length of the bar for the value as a fraction
+// of the range of values in the column. Bars start at 0 unless the column has
+// negative values, in which case they start at the smallest value. NaN and
+// infinite values get no bar.
+func getDataBarFraction(number float64, stats ColumnStats) float64 {
+	lo := math.Min(0, stats.Min)
+	if stats.Max == lo {
+		return 0
+	}
+	if fraction := (number - lo) / (stats.Max - lo); isFinite(fraction) {
+		return fraction
+	}
+	return 0
+}
+
+// ColorRuleIf colors the cell if the condition returns true for its value.
+func ColorRuleIf(condition func(val interface{}) bool, colors text.Colors) ColorRule {
+	return func(val interface{}, stats ColumnStats) text.Colors {
+		if condition(val) {
+			return colors
+		}
+		return nil
+	}
+}
+
+// ColorRuleDuplicates colors the cell if its value occurs more than once in
+// the column.
+func ColorRuleDuplicates(colors text.Colors) ColorRule {
+	return func(val interface{}, stats ColumnStats) text.Colors {
+		if stats.Occurrences[fmt.Sprint(val)] > 1 {
+			return colors
+		}
+		return nil
+	}
+}
+
+// ColorRuleGreaterThan colors the cell if its value is a number greater than
+// the threshold.
+func ColorRuleGreaterThan(threshold float64, colors text.Colors) ColorRule {
+	return func(val interface{}, stats ColumnStats) text.Colors {
+		if number, ok := toFloat64(val); ok && number > threshold {
+			return colors
+		}
+		return nil
+	}
+}
+
+// ColorRuleLessThan colors the cell if its value is a number less than the
+// threshold.
+func ColorRuleLessThan(threshold float64, colors text.Colors) ColorRule {
+	return func(val interface{}, stats ColumnStats) text.Colors {
+		if number, ok := toFloat64(val); ok && number < threshold {
+			return colors
+		}
+		return nil
+	}
+}
+
+// ColorRuleMax colors the cell if its value is the largest number in the
+// column (ignoring NaN and infinite values).
+func ColorRuleMax(colors text.Colors) ColorRule {
+	return func(val interface{}, stats ColumnStats) text.Colors {
+		if number, ok := toFloat64(val); ok && number == stats.Max {
+			return colors
+		}
+		return nil
+	}
+}
+
+// ColorRuleMin colors the cell if its value is the smallest number in the
+// column (ignoring NaN and infinite values).
+func ColorRuleMin(colors text.Colors) ColorRule {
+	return func(val interface{}, stats ColumnStats) text.Colors {
+		if number, ok := toFloat64(val); ok && number == stats.Min {
+			return colors
+		}
+		return nil
+	}
+}
+
+// ColorRuleScale colors numeric cells using a color scale between the smallest
+// and the largest number in the column. The range gets divided into as many
+// equal buckets as the number of colors provided. For ex., the following would
+// color the lowest third of the values Green, the middle third Yellow and the
+// highest third Red (NaN and infinite values are not colored):
+//  ColorRuleScale(text.Colors{text.FgGreen}, text.Colors{text.FgYellow}, text.Colors{text.FgRed})
+func ColorRuleScale(colors ...text.Colors) ColorRule {
+	return func(val interface{}, stats ColumnStats) text.Colors {
+		number, ok := toFloat64(val)
+		if !ok || !isFinite(number) || len(colors) == 0 {
+			return nil
+		}
+		if stats.Max == stats.Min {
+			return colors[0]
+		}
+		bucketIdx := int((number - stats.Min) / (stats.Max - stats.Min) * float64(len(colors)))
+		if bucketIdx >= len(colors) {
+			bucketIdx = len(colors) - 1
+		} else if bucketIdx < 0 {
+			bucketIdx = 0
+		}
+		return colors[bucketIdx]
+	}
+}
@@ -0,0 +1,110 @@
+package table
+
+import (
+	"math"
+	"testing"
+
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewColumnStats(t *testing.T) {
+	stats := newColumnStats([]Row{{1, 3.5}, {2, "foo"}, {3, -1}, {4, "foo"}, {5}}, 1)
+	assert.Equal(t, 3.5, stats.Max)
+	assert.Equal(t, -1.0, stats.Min)
+	assert.Equal(t, 2, stats.NumNumbers)
+	assert.Equal(t, map[string]int{"3.5": 1, "foo": 2, "-1": 1}, stats.Occurrences)
+
+	// NaN and infinite values are left out of the numeric stats
+	stats = newColumnStats([]Row{{1.0}, {math.NaN()}, {math.Inf(1)}, {math.Inf(-1)}, {2}}, 0)
+	assert.Equal(t, 2.0, stats.Max)
+	assert.Equal(t, 1.0, stats.Min)
+	assert.Equal(t, 2, stats.NumNumbers)
+}
+
+func Test_getDataBarFraction(t *testing.T) {
+	stats := newColumnStats([]Row{{1.0}, {math.NaN()}, {math.Inf(1)}, {4}}, 0)
+
+	assert.Equal(t, 0.25, getDataBarFraction(1, stats))
+	assert.Equal(t, 1.0, getDataBarFraction(4, stats))
+	assert.Equal(t, 0.0, getDataBarFraction(math.NaN(), stats))
+	assert.Equal(t, 0.0, getDataBarFraction(math.Inf(1), stats))
+	assert.Equal(t, 0.0, getDataBarFraction(math.Inf(-1), stats))
+	assert.Equal(t, 0.0, getDataBarFraction(1, newColumnStats([]Row{{math.NaN()}}, 0)))
+}
+
+func TestColorRuleIf(t *testing.T) {
+	rule := ColorRuleIf(func(val interface{}) bool { return val == "foo" }, testColor)
+
+	assert.Equal(t, testColor, rule("foo", ColumnStats{}))
+	assert.Nil(t, rule("bar", ColumnStats{}))
+}
+
+func TestColorRuleDuplicates(t *testing.T) {
+	rule := ColorRuleDuplicates(testColor)
+	stats := newColumnStats([]Row{{"foo"}, {"bar"}, {"foo"}}, 0)
+
+	assert.Equal(t, testColor, rule("foo", stats))
+	assert.Nil(t, rule("bar", stats))
+}
+
+func TestColorRuleGreaterThan(t *testing.T) {
+	rule := ColorRuleGreaterThan(10, testColor)
+
+	assert.Equal(t, testColor, rule(11, ColumnStats{}))
+	assert.Equal(t, testColor, rule(uint8(11), ColumnStats{}))
+	assert.Equal(t, testColor, rule(10.5, ColumnStats{}))
+	assert.Nil(t, rule(10, ColumnStats{}))
+	assert.Nil(t, rule("11", ColumnStats{}))
+	assert.Nil(t, rule(nil, ColumnStats{}))
+}
+
+func TestColorRuleLessThan(t *testing.T) {
+	rule := ColorRuleLessThan(10, testColor)
+
+	assert.Equal(t, testColor, rule(9, ColumnStats{}))
+	assert.Equal(t, testColor, rule(float32(-0.5), ColumnStats{}))
+	assert.Nil(t, rule(10, ColumnStats{}))
+	assert.Nil(t, rule("9", ColumnStats{}))
+}
+
+func TestColorRuleMaxMin(t *testing.T) {
+	stats := newColumnStats([]Row{{1}, {5}, {3}}, 0)
+
+	assert.Equal(t, testColor, ColorRuleMax(testColor)(5, stats))
+	assert.Nil(t, ColorRuleMax(testColor)(3, stats))
+	assert.Equal(t, testColor, ColorRuleMin(testColor)(1, stats))
+	assert.Nil(t, ColorRuleMin(testColor)(3, stats))
+
+	stats = newColumnStats([]Row{{1.0}, {math.NaN()}, {math.Inf(1)}, {math.Inf(-1)}, {5.0}}, 0)
+	assert.Equal(t, testColor, ColorRuleMax(testColor)(5.0, stats))
+	assert.Nil(t, ColorRuleMax(testColor)(math.Inf(1), stats))
+	assert.Nil(t, ColorRuleMax(testColor)(math.NaN(), stats))
+	assert.Equal(t, testColor, ColorRuleMin(testColor)(1.0, stats))
+	assert.Nil(t, ColorRuleMin(testColor)(math.Inf(-1), stats))
+	assert.Nil(t, ColorRuleMin(testColor)(math.NaN(), stats))
+}
+
+func TestColorRuleScale(t *testing.T) {
+	low, mid, high := text.Colors{text.FgGreen}, text.Colors{text.FgYellow}, text.Colors{text.FgRed}
+	rule := ColorRuleScale(low, mid, high)
+	stats := newColumnStats([]Row{{0}, {30}, {50}, {90}}, 0)
+
+	assert.Equal(t, low, rule(0, stats))
+	assert.Equal(t, low, rule(29, stats))
+	assert.Equal(t, mid, rule(30, stats))
+	assert.Equal(t, mid, rule(50, stats))
+	assert.Equal(t, high, rule(60, stats))
+	assert.Equal(t, high, rule(90, stats))
+	assert.Nil(t, rule("90", stats))
+	assert.Equal(t, low, rule(5, newColumnStats([]Row{{5}}, 0)))
+	assert.Nil(t, ColorRuleScale()(5, stats))
+
+	stats = newColumnStats([]Row{{0.0}, {math.NaN()}, {math.Inf(1)}, {90.0}}, 0)
+	assert.Equal(t, low, rule(0.0, stats))
+	assert.Equal(t, mid, rule(30.0, stats))
+	assert.Equal(t, high, rule(90.0, stats))
+	assert.Nil(t, rule(math.NaN(), stats))
+	assert.Nil(t, rule(math.Inf(1), stats))
+	assert.Nil(t, rule(math.Inf(-1), stats))
+}
@@ -33,6 +33,12 @@ type ColumnConfig struct {
 	// * Style().Color.Row == Style().Color.RowAlternate (or not set)
 	AutoMerge bool
 
+	// ColorRules define colors to be used on cells of regular rows based on
+	// their raw values; for ex., color values above a threshold Red, or make
+	// the largest value Bold. The colors of all matching rules are applied (in
+	// order) on top of the colors determined by RowPainter/Colors/Style.
+	// Refer to the ColorRule* functions for ready-to-use rules.
+	ColorRules []ColorRule
 	// Colors defines the colors to be used on the column
 	Colors text.Colors
 	// ColorsFooter defines the colors to be used on the column in Footer rows
@@ -40,11 +46,22 @@ type ColumnConfig struct {
 	// ColorsHeader defines the colors to be used on the column in Header rows
 	ColorsHeader text.Colors
 
+	// DataBar when set renders numeric values of regular rows as horizontal
+	// bars scaled to the values in the column. Rendered as inline SVG in HTML
+	// render mode, and not rendered at all in the other (data) render modes
+	// like CSV and Markdown.
+	DataBar *DataBar
+
 	// Hidden when set to true will prevent the column from being rendered.
 	// This is useful in cases like needing a column for sorting, but not for
 	// display.
 	Hidden bool
 
+	// Sparkline when set renders numeric slices (ex.: []float64) in regular
+	// rows as sparklines. Rendered as inline SVG in HTML render mode, and not
+	// rendered at all in the other (data) render modes like CSV and Markdown.
+	Sparkline *Sparkline
+
 	// Transformer is a custom-function that changes the way the value gets
 	// rendered to the console. Refer to text/transformer.go for ready-to-use
 	// Transformer functions.
@@ -80,6 +97,32 @@ func (c ColumnConfig) getWidthMaxEnforcer() WidthEnforcer {
 	return text.WrapText
 }
 
+// DataBar contains configurations that determine the way numeric values get
+// rendered as horizontal bars within the cells of a column.
+type DataBar struct {
+	// Colors defines the colors to be used on the bar
+	Colors text.Colors
+	// HideValue when set to true will prevent the (transformed) value from
+	// being rendered next to the bar
+	HideValue bool
+	// Width defines the character length of the bar; default: 10
+	Width int
+}
+
+func (d DataBar) getWidth() int {
+	if d.Width > 0 {
+		return d.Width
+	}
+	return 10
+}
+
+// Sparkline contains configurations that determine the way numeric slices get
+// rendered as sparklines within the cells of a column.
+type Sparkline struct {
+	// Colors defines the colors to be used on the sparkline
+	Colors text.Colors
+}
+
 // RowConfig contains configurations that determine and modify the way the
 // contents of a row get rendered.
 type RowConfig struct {
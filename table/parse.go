@@ -0,0 +1,388 @@
+package table
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/text"
+)
+
+// ParsedTable contains the contents of a Table read back from one of its
+// rendered forms using Parse, ParseCSV, ParseHTML or ParseMarkdown. All the
+// values in the rows are strings exactly as they were rendered (without any
+// colors), and so the headers and footers may be in upper-case, the numbers
+// may be formatted, etc.
+type ParsedTable struct {
+	Caption string
+	Footer  []Row
+	Header  []Row
+	Rows    []Row
+	Title   string
+}
+
+// Writer returns a new Writer with the contents of the parsed table, which
+// can be used to render it again (maybe in a different style/format).
+func (p ParsedTable) Writer() Writer {
+	tw := NewWriter()
+	if p.Title != "" {
+		tw.SetTitle("%s", p.Title)
+	}
+	for _, row := range p.Header {
+		tw.AppendHeader(row)
+	}
+	tw.AppendRows(p.Rows)
+	for _, row := range p.Footer {
+		tw.AppendFooter(row)
+	}
+	if p.Caption != "" {
+		tw.SetCaption("%s", p.Caption)
+	}
+	return tw
+}
+
+// parseBoxChars contains the characters used to draw the boxes of a table.
+type parseBoxChars struct {
+	horizontals map[rune]bool
+	junctions   map[rune]bool
+	verticals   map[rune]bool
+}
+
+var (
+	// parseBoxes contains the characters used in all the built-in box styles
+	parseBoxes = parseGetBoxChars(StyleBoxDefault, StyleBoxBold, StyleBoxDouble, StyleBoxLight, StyleBoxRounded)
+)
+
+func parseGetBoxChars(boxes ...BoxStyle) parseBoxChars {
+	chars := parseBoxChars{
+		horizontals: make(map[rune]bool),
+		junctions:   make(map[rune]bool),
+		verticals:   make(map[rune]bool),
+	}
+	add := func(set map[rune]bool, strs ...string) {
+		for _, str := range strs {
+			for _, r := range str {
+				if r != ' ' {
+					set[r] = true
+				}
+			}
+		}
+	}
+	for _, box := range boxes {
+		add(chars.horizontals, box.MiddleHorizontal)
+		add(chars.junctions, box.BottomLeft, box.BottomRight, box.BottomSeparator,
+			box.LeftSeparator, box.MiddleSeparator, box.RightSeparator,
+			box.TopLeft, box.TopRight, box.TopSeparator)
+		add(chars.verticals, box.Left, box.MiddleVertical, box.Right)
+	}
+	return chars
+}
+
+// Parse reads back a table rendered by Render using any of the built-in box
+// styles (StyleBoxDefault, StyleBoxBold, StyleBoxDouble, StyleBoxLight and
+// StyleBoxRounded), with or without borders. Escape sequences (colors) are
+// ignored, and text around the table is returned as the Title and Caption.
+//
+// The lines in between the separators are grouped into the header, rows and
+// the footer as below:
+//   - a single group is read as the rows
+//   - two groups are read as the header and the rows
+//   - three or more groups are read as the header, the rows (all the groups
+//     in the middle) and the footer
+//
+// Every line in the table is read as a row, and so cells with multiple lines
+// of text are returned as multiple rows. Cells merged horizontally get the
+// same value in every column they span, while cells merged vertically are
+// read as empty cells in every row after the first. Tables rendered without
+// separators between the columns (like the ones using StyleColoredBright) are
+// read as tables with a single column, as are tables with a single line when
+// they have no separator lines either. Without separator lines, the columns
+// are found using the vertical lines found in every line of the table, and so
+// cells merged horizontally may not be read correctly.
+func Parse(str string) (*ParsedTable, error) {
+	var lines [][]rune
+	for _, line := range strings.Split(text.StripEscape(str), "\n") {
+		lines = append(lines, parseLineColumns(line))
+	}
+
+	// find the separator lines and the boundaries of the columns
+	separators := make([]bool, len(lines))
+	for idx, line := range lines {
+		separators[idx] = parseIsSeparator(line)
+	}
+	boundaries := parseBoundaries(lines, separators)
+
+	// find the lines that belong to the table
+	firstIdx, lastIdx := -1, -1
+	for idx, line := range lines {
+		if separators[idx] || parseIsTableLine(line, boundaries) {
+			if firstIdx == -1 {
+				firstIdx = idx
+			}
+			lastIdx = idx
+		}
+	}
+	if firstIdx == -1 {
+		return nil, fmt.Errorf("no table found")
+	}
+
+	rsp := &ParsedTable{}
+	var titleLines, captionLines []string
+	for idx := 0; idx < len(lines); idx++ {
+		if separators[idx] || (idx >= firstIdx && idx <= lastIdx) {
+			continue
+		}
+		line := parseTrimBorder(lines[idx])
+		if idx < firstIdx {
+			titleLines = append(titleLines, line)
+		} else {
+			captionLines = append(captionLines, line)
+		}
+	}
+	// the title of a table with borders is drawn within the box, and so it is
+	// not classified as a line in the table using the column boundaries
+	for idx := firstIdx; idx <= lastIdx; idx++ {
+		if separators[idx] {
+			continue
+		}
+		if parseIsTableLine(lines[idx], boundaries) {
+			break
+		}
+		titleLines = append(titleLines, parseTrimBorder(lines[idx]))
+		firstIdx = idx + 1
+	}
+	rsp.Title = strings.Trim(strings.Join(titleLines, "\n"), "\n")
+	rsp.Caption = strings.Trim(strings.Join(captionLines, "\n"), "\n")
+
+	// split the lines into groups using the separators
+	var groups [][]Row
+	var group []Row
+	for idx := firstIdx; idx <= lastIdx+1; idx++ {
+		if idx > lastIdx || separators[idx] {
+			if len(group) > 0 {
+				groups = append(groups, group)
+				group = nil
+			}
+			continue
+		}
+		if strings.TrimSpace(string(lines[idx])) != "" {
+			group = append(group, parseLineCells(lines[idx], boundaries))
+		}
+	}
+	switch len(groups) {
+	case 0:
+	case 1:
+		rsp.Rows = groups[0]
+	case 2:
+		rsp.Header, rsp.Rows = groups[0], groups[1]
+	default:
+		rsp.Header, rsp.Footer = groups[0], groups[len(groups)-1]
+		for _, group := range groups[1 : len(groups)-1] {
+			rsp.Rows = append(rsp.Rows, group...)
+		}
+	}
+	return rsp, nil
+}
+
+// parseBoundaries returns the positions of the characters separating the
+// columns, along with virtual positions just outside the table when there are
+// no borders.
+func parseBoundaries(lines [][]rune, separators []bool) []int {
+	positions, maxWidth := make(map[int]int), 0
+	for idx, line := range lines {
+		if separators[idx] {
+			for pos, r := range line {
+				if parseBoxes.junctions[r] {
+					positions[pos] = 1
+				}
+			}
+			maxWidth = parseMax(maxWidth, len(line))
+		}
+	}
+	// without separators, use the positions with vertical lines in all the
+	// lines of the table
+	if len(positions) == 0 {
+		positions, maxWidth = parseVerticalPositions(lines)
+	}
+	// without any box characters, the whole text is a single column
+	if maxWidth == 0 {
+		for _, line := range lines {
+			maxWidth = parseMax(maxWidth, len(line))
+		}
+	}
+
+	maxCount := 0
+	for _, count := range positions {
+		maxCount = parseMax(maxCount, count)
+	}
+	var boundaries []int
+	for pos, count := range positions {
+		if count*2 >= maxCount {
+			boundaries = append(boundaries, pos)
+		}
+	}
+	sort.Ints(boundaries)
+	if len(boundaries) == 0 || boundaries[0] > 0 {
+		boundaries = append([]int{-1}, boundaries...)
+	}
+	if len(boundaries) == 1 || boundaries[len(boundaries)-1] < maxWidth-1 {
+		boundaries = append(boundaries, maxWidth)
+	}
+	return boundaries
+}
+
+// parseVerticalPositions returns the positions with vertical lines in every
+// non-empty line in between the first and the last lines having any, along
+// with the width of the widest of those lines. Vertical characters in the text
+// of a few cells (like "a | b") do not line up across all the lines, and so
+// are not returned; nor are they when just one line has any, as the text is
+// then read as a single column.
+func parseVerticalPositions(lines [][]rune) (map[int]int, int) {
+	firstIdx, lastIdx := -1, -1
+	for idx, line := range lines {
+		for _, r := range line {
+			if parseBoxes.verticals[r] {
+				if firstIdx == -1 {
+					firstIdx = idx
+				}
+				lastIdx = idx
+				break
+			}
+		}
+	}
+	positions, maxWidth := make(map[int]int), 0
+	if firstIdx == lastIdx {
+		return positions, maxWidth
+	}
+
+	counts, numLines := make(map[int]int), 0
+	for _, line := range lines[firstIdx : lastIdx+1] {
+		if strings.TrimSpace(string(line)) == "" {
+			continue
+		}
+		for pos, r := range line {
+			if parseBoxes.verticals[r] {
+				counts[pos]++
+			}
+		}
+		maxWidth = parseMax(maxWidth, len(line))
+		numLines++
+	}
+	for pos, count := range counts {
+		if count == numLines {
+			positions[pos] = 1
+		}
+	}
+	if len(positions) == 0 {
+		maxWidth = 0
+	}
+	return positions, maxWidth
+}
+
+// parseIsSeparator returns true if the line has only box characters
+// (including at least one horizontal line) and spaces.
+func parseIsSeparator(line []rune) bool {
+	hasHorizontal, hasJunction := false, false
+	for _, r := range line {
+		switch {
+		case parseBoxes.horizontals[r]:
+			hasHorizontal = true
+		case parseBoxes.junctions[r]:
+			hasJunction = true
+		case parseBoxes.verticals[r], r == ' ':
+		default:
+			return false
+		}
+	}
+	// a row with a cell containing just "-" is not a separator
+	return hasHorizontal && (hasJunction || parseBoxes.horizontals[line[0]])
+}
+
+// parseIsTableLine returns true if the line has a vertical line on any of the
+// boundaries in between the columns. When there is just one column, lines
+// with a vertical line on the left border are considered a part of the table.
+func parseIsTableLine(line []rune, boundaries []int) bool {
+	inner := boundaries[1 : len(boundaries)-1]
+	if len(inner) == 0 {
+		if boundaries[0] < 0 {
+			return strings.TrimSpace(string(line)) != ""
+		}
+		return len(line) > 0 && parseBoxes.verticals[line[boundaries[0]]]
+	}
+	for _, pos := range inner {
+		if pos < len(line) && parseBoxes.verticals[line[pos]] {
+			return true
+		}
+	}
+	return false
+}
+
+// parseLineCells returns the values of the cells in the line; cells spanning
+// multiple columns (without a vertical line in between) get the same value in
+// all of them.
+func parseLineCells(line []rune, boundaries []int) Row {
+	row := make(Row, len(boundaries)-1)
+	for colIdx := 0; colIdx < len(row); {
+		start, endIdx := boundaries[colIdx]+1, colIdx+1
+		for endIdx < len(row) {
+			pos := boundaries[endIdx]
+			if pos < len(line) && parseBoxes.verticals[line[pos]] {
+				break
+			}
+			endIdx++
+		}
+		value := parseTrimText(line, start, boundaries[endIdx])
+		for ; colIdx < endIdx; colIdx++ {
+			row[colIdx] = value
+		}
+	}
+	return row
+}
+
+// parseLineColumns returns the runes in the line placed in the columns of
+// the terminal, with wide characters followed by zero-value placeholders.
+func parseLineColumns(line string) []rune {
+	var rsp []rune
+	for _, r := range strings.TrimRight(line, "\r") {
+		rsp = append(rsp, r)
+		for width := text.RuneWidth(r); width > 1; width-- {
+			rsp = append(rsp, 0)
+		}
+	}
+	return rsp
+}
+
+// parseTrimBorder returns the text in the line without the borders around it.
+func parseTrimBorder(line []rune) string {
+	str := parseTrimText(line, 0, len(line))
+	return strings.TrimSpace(strings.TrimFunc(str, func(r rune) bool {
+		return parseBoxes.verticals[r]
+	}))
+}
+
+// parseTrimText returns the text in the given range of columns of the line,
+// without the spaces around it.
+func parseTrimText(line []rune, start, end int) string {
+	start, end = parseMax(start, 0), parseMin(end, len(line))
+	var out strings.Builder
+	for pos := start; pos < end; pos++ {
+		if line[pos] != 0 {
+			out.WriteRune(line[pos])
+		}
+	}
+	return strings.TrimSpace(out.String())
+}
+
+func parseMax(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func parseMin(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,112 @@
+package table
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ParseCSV reads back a table rendered by RenderCSV. The first record is read
+// as the header and the rest as the rows, as the footers cannot be told apart
+// from the rows in this format. Lines with fewer fields than the records of the
+// table before/after it are returned as the Title/Caption; this cannot be done
+// for tables with a single column.
+//
+// Quoted fields may contain new-lines, and the escape sequences used by
+// RenderCSV (\, and \") as well as doubled quotes ("") are supported within
+// them.
+func ParseCSV(str string) (*ParsedTable, error) {
+	records := parseCSVRecords(str)
+	numColumns := 0
+	for _, record := range records {
+		numColumns = parseMax(numColumns, len(record))
+	}
+
+	// find the records that belong to the table
+	firstIdx, lastIdx := -1, -1
+	for idx, record := range records {
+		if len(record) == numColumns {
+			if firstIdx == -1 {
+				firstIdx = idx
+			}
+			lastIdx = idx
+		}
+	}
+	if firstIdx == -1 {
+		return nil, fmt.Errorf("no table found")
+	}
+
+	rsp := &ParsedTable{
+		Title:   parseCSVText(records[:firstIdx]),
+		Caption: parseCSVText(records[lastIdx+1:]),
+	}
+	for idx := firstIdx; idx <= lastIdx; idx++ {
+		row := make(Row, numColumns)
+		for colIdx := range row {
+			row[colIdx] = ""
+			if colIdx < len(records[idx]) {
+				row[colIdx] = records[idx][colIdx]
+			}
+		}
+		if idx == firstIdx {
+			rsp.Header = append(rsp.Header, row)
+		} else {
+			rsp.Rows = append(rsp.Rows, row)
+		}
+	}
+	return rsp, nil
+}
+
+// parseCSVRecords splits the text into records with fields.
+func parseCSVRecords(str string) [][]string {
+	var records [][]string
+	var record []string
+	var field strings.Builder
+	inQuotes := false
+	runes := []rune(strings.Replace(str, "\r\n", "\n", -1))
+	for idx := 0; idx < len(runes); idx++ {
+		r := runes[idx]
+		if inQuotes {
+			switch {
+			case r == '\\' && idx+1 < len(runes) && (runes[idx+1] == ',' || runes[idx+1] == '"'):
+				field.WriteRune(runes[idx+1])
+				idx++
+			case r == '"' && idx+1 < len(runes) && runes[idx+1] == '"':
+				field.WriteRune(r)
+				idx++
+			case r == '"':
+				inQuotes = false
+			default:
+				field.WriteRune(r)
+			}
+			continue
+		}
+
+		switch r {
+		case '"':
+			inQuotes = true
+		case ',':
+			record = append(record, field.String())
+			field.Reset()
+		case '\n':
+			record = append(record, field.String())
+			records = append(records, record)
+			field.Reset()
+			record = nil
+		default:
+			field.WriteRune(r)
+		}
+	}
+	if field.Len() > 0 || len(record) > 0 {
+		records = append(records, append(record, field.String()))
+	}
+	return records
+}
+
+// parseCSVText returns the text in the records outside the table.
+func parseCSVText(records [][]string) string {
+	lines := make([]string, len(records))
+	for idx, record := range records {
+		lines[idx] = strings.Join(record, ",")
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,48 @@
+package table
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCSV(t *testing.T) {
+	tw := NewWriter()
+	tw.AppendHeader(testHeader)
+	tw.AppendRows(testRows)
+	tw.AppendRow(Row{0, "Valar", "Morghulis", 0, "Faceless\n\"Men\", Braavos"})
+	tw.AppendFooter(testFooter)
+	tw.SetCaption(testCaption)
+	tw.SetTitle(testTitle1)
+
+	parsed, err := ParseCSV(tw.RenderCSV())
+	if assert.Nil(t, err) {
+		assert.Equal(t, testTitle1, parsed.Title)
+		assert.Equal(t, []Row{{"#", "First Name", "Last Name", "Salary", ""}}, parsed.Header)
+		assert.Equal(t, []Row{
+			{"1", "Arya", "Stark", "3000", ""},
+			{"20", "Jon", "Snow", "2000", "You know nothing, Jon Snow!"},
+			{"300", "Tyrion", "Lannister", "5000", ""},
+			{"0", "Valar", "Morghulis", "0", "Faceless\n\"Men\", Braavos"},
+			{"", "", "Total", "10000", ""},
+		}, parsed.Rows)
+		assert.Empty(t, parsed.Footer)
+		assert.Equal(t, testCaption, parsed.Caption)
+	}
+}
+
+func TestParseCSV_Standard(t *testing.T) {
+	parsed, err := ParseCSV("Name,Quote\r\nJon,\"You know \"\"nothing\"\"\"\r\n")
+	if assert.Nil(t, err) {
+		assert.Empty(t, parsed.Title)
+		assert.Equal(t, []Row{{"Name", "Quote"}}, parsed.Header)
+		assert.Equal(t, []Row{{"Jon", "You know \"nothing\""}}, parsed.Rows)
+		assert.Empty(t, parsed.Caption)
+	}
+}
+
+func TestParseCSV_NoTable(t *testing.T) {
+	parsed, err := ParseCSV("")
+	assert.Nil(t, parsed)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,135 @@
+package table
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ParseHTML reads back the first table in the HTML rendered by RenderHTML (or
+// RenderHTMLDocument). The rows within "thead", "tbody" and "tfoot" are read
+// as the header, rows and footer respectively; rows outside these are read as
+// the header if they have only "th" cells. The "caption" with the CSS class
+// "title" is returned as the Title, and any other "caption" as the Caption.
+//
+// The text within the cells is returned without any of the HTML tags, with
+// "<br/>" converted to new-lines, the entities decoded, and "&nbsp;" (used for
+// empty cells) read as an empty string. Charts rendered as inline SVGs are
+// read as empty cells.
+func ParseHTML(str string) (*ParsedTable, error) {
+	idx := strings.Index(strings.ToLower(str), "<table")
+	if idx == -1 {
+		return nil, fmt.Errorf("no table found")
+	}
+
+	decoder := xml.NewDecoder(strings.NewReader(str[idx:]))
+	decoder.Strict = false
+	decoder.AutoClose = xml.HTMLAutoClose
+	decoder.Entity = xml.HTMLEntity
+
+	rsp := &ParsedTable{}
+	var section, captionClass string
+	var row Row
+	var caption, cell *strings.Builder
+	var rowHasOnlyTH bool
+	// the end tags of cells and rows are optional in HTML
+	endCell := func() {
+		if cell != nil && row != nil {
+			row = append(row, strings.TrimSpace(cell.String()))
+		}
+		cell = nil
+	}
+	endRow := func() {
+		endCell()
+		if row != nil {
+			switch {
+			case section == "thead", section == "" && rowHasOnlyTH:
+				rsp.Header = append(rsp.Header, row)
+			case section == "tfoot":
+				rsp.Footer = append(rsp.Footer, row)
+			default:
+				rsp.Rows = append(rsp.Rows, row)
+			}
+		}
+		row = nil
+	}
+	for numTables := 0; ; {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to parse HTML: %v", err)
+		}
+
+		switch token := token.(type) {
+		case xml.StartElement:
+			switch strings.ToLower(token.Name.Local) {
+			case "br":
+				if caption != nil {
+					caption.WriteRune('\n')
+				} else if cell != nil {
+					cell.WriteRune('\n')
+				}
+			case "caption":
+				captionClass = " caption "
+				for _, attr := range token.Attr {
+					if strings.ToLower(attr.Name.Local) == "class" {
+						captionClass = " " + attr.Value + " "
+					}
+				}
+				caption = &strings.Builder{}
+			case "svg":
+				if err := decoder.Skip(); err != nil {
+					return nil, fmt.Errorf("failed to parse HTML: %v", err)
+				}
+			case "table":
+				numTables++
+			case "tbody", "tfoot", "thead":
+				endRow()
+				section = strings.ToLower(token.Name.Local)
+			case "td", "th":
+				endCell()
+				if strings.ToLower(token.Name.Local) == "td" {
+					rowHasOnlyTH = false
+				}
+				cell = &strings.Builder{}
+			case "tr":
+				endRow()
+				row, rowHasOnlyTH = Row{}, true
+			}
+		case xml.EndElement:
+			switch strings.ToLower(token.Name.Local) {
+			case "caption":
+				if caption != nil {
+					if strings.Contains(captionClass, " title ") {
+						rsp.Title = strings.TrimSpace(caption.String())
+					} else {
+						rsp.Caption = strings.TrimSpace(caption.String())
+					}
+				}
+				caption = nil
+			case "table":
+				numTables--
+			case "td", "th":
+				endCell()
+			case "tbody", "tfoot", "thead":
+				endRow()
+				section = ""
+			case "tr":
+				endRow()
+			}
+		case xml.CharData:
+			if caption != nil {
+				caption.Write(token)
+			} else if cell != nil {
+				cell.Write(token)
+			}
+		}
+		if numTables == 0 {
+			break
+		}
+	}
+	endRow()
+	return rsp, nil
+}
@@ -0,0 +1,56 @@
+package table
+
+import (
+	"testing"
+
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseHTML(t *testing.T) {
+	tw := NewWriter()
+	tw.AppendHeader(testHeader)
+	tw.AppendRows(testRows)
+	tw.AppendRow(Row{0, "<Valar>", text.FgRed.Sprint("Morghulis"), 0, "Faceless\nMen"})
+	tw.AppendFooter(testFooter)
+	tw.SetCaption(testCaption)
+	tw.SetTitle(testTitle1)
+	tw.Style().HTML.ConvertANSI = true
+
+	for _, out := range []string{tw.RenderHTML(), tw.RenderHTMLDocument(HTMLDocumentOptions{Filterable: true, Sortable: true})} {
+		parsed, err := ParseHTML(out)
+		if assert.Nil(t, err) {
+			assert.Equal(t, testTitle1, parsed.Title)
+			assert.Equal(t, []Row{{"#", "First Name", "Last Name", "Salary", ""}}, parsed.Header)
+			assert.Equal(t, []Row{
+				{"1", "Arya", "Stark", "3000", ""},
+				{"20", "Jon", "Snow", "2000", "You know nothing, Jon Snow!"},
+				{"300", "Tyrion", "Lannister", "5000", ""},
+				{"0", "<Valar>", "Morghulis", "0", "Faceless\nMen"},
+			}, parsed.Rows)
+			assert.Equal(t, []Row{{"", "", "Total", "10000", ""}}, parsed.Footer)
+			assert.Equal(t, testCaption, parsed.Caption)
+		}
+	}
+}
+
+func TestParseHTML_Plain(t *testing.T) {
+	parsed, err := ParseHTML(`<p>Stark</p>
+<TABLE>
+  <TR><TH>Name<TH>Weapon</TR>
+  <TR><TD>Arya<TD>Needle<br>(Sword)</TR>
+  <TR><TD>Jon<TD><svg><text>x</text></svg></TR>
+</TABLE>
+<table><tr><td>ignored</td></tr></table>`)
+	if assert.Nil(t, err) {
+		assert.Equal(t, []Row{{"Name", "Weapon"}}, parsed.Header)
+		assert.Equal(t, []Row{{"Arya", "Needle\n(Sword)"}, {"Jon", ""}}, parsed.Rows)
+		assert.Empty(t, parsed.Footer)
+	}
+}
+
+func TestParseHTML_NoTable(t *testing.T) {
+	parsed, err := ParseHTML("<p>Game of Thrones</p>")
+	assert.Nil(t, parsed)
+	assert.NotNil(t, err)
+}
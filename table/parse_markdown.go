@@ -0,0 +1,130 @@
+package table
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+)
+
+var (
+	// markdownSeparatorRegex matches the row separating the header from the
+	// rows in a Markdown table
+	markdownSeparatorRegex = regexp.MustCompile(`^\|(\s*:?-+:?\s*\|)+$`)
+	// markdownNewlineRegex matches the HTML line-breaks used for new-lines
+	markdownNewlineRegex = regexp.MustCompile(`<br\s*/?>`)
+)
+
+// ParseMarkdown reads back a table rendered by RenderMarkdown. The rows above
+// the separator row ("| --- |") are read as the header and the rest as the
+// rows. The footers cannot be told apart from the rows in this format, unless
+// they were rendered in bold (MarkdownOptions.BoldFooter); the rows at the
+// end with every non-empty cell in bold are read as the footer. The heading
+// ("# ...") above the table is returned as the Title, and the text below it
+// (without the surrounding "_") as the Caption.
+//
+// The escaping done by RenderMarkdown (see MarkdownOptions.EscapeText) is
+// reverted, and HTML line-breaks are read as new-lines.
+func ParseMarkdown(str string) (*ParsedTable, error) {
+	lines := strings.Split(strings.Replace(str, "\r\n", "\n", -1), "\n")
+
+	// find the lines that belong to the table
+	firstIdx, lastIdx, separatorIdx := -1, -1, -1
+	for idx, line := range lines {
+		line = strings.TrimSpace(line)
+		if strings.HasPrefix(line, "|") && strings.HasSuffix(line, "|") && len(line) > 1 {
+			if firstIdx == -1 {
+				firstIdx = idx
+			}
+			if separatorIdx == -1 && markdownSeparatorRegex.MatchString(line) {
+				separatorIdx = idx
+			}
+			lastIdx = idx
+		}
+	}
+	if firstIdx == -1 {
+		return nil, fmt.Errorf("no table found")
+	}
+
+	rsp := &ParsedTable{
+		Title:   parseMarkdownUnescape(strings.TrimPrefix(strings.Join(lines[:firstIdx], "\n"), "# ")),
+		Caption: strings.Join(lines[lastIdx+1:], "\n"),
+	}
+	if len(rsp.Caption) > 1 && strings.HasPrefix(rsp.Caption, "_") && strings.HasSuffix(rsp.Caption, "_") {
+		rsp.Caption = rsp.Caption[1 : len(rsp.Caption)-1]
+	}
+	rsp.Caption = parseMarkdownUnescape(rsp.Caption)
+	for idx := firstIdx; idx <= lastIdx; idx++ {
+		if idx == separatorIdx {
+			continue
+		}
+		row := parseMarkdownRow(strings.TrimSpace(lines[idx]))
+		if idx < separatorIdx {
+			rsp.Header = append(rsp.Header, row)
+		} else {
+			rsp.Rows = append(rsp.Rows, row)
+		}
+	}
+	for len(rsp.Rows) > 0 && parseMarkdownIsBold(rsp.Rows[len(rsp.Rows)-1]) {
+		row := rsp.Rows[len(rsp.Rows)-1]
+		for colIdx, cell := range row {
+			if str := cell.(string); str != "" {
+				row[colIdx] = str[2 : len(str)-2]
+			}
+		}
+		rsp.Footer = append([]Row{row}, rsp.Footer...)
+		rsp.Rows = rsp.Rows[:len(rsp.Rows)-1]
+	}
+	return rsp, nil
+}
+
+// parseMarkdownIsBold returns true if all the non-empty cells in the row (and
+// at least one) are in bold.
+func parseMarkdownIsBold(row Row) bool {
+	numBold := 0
+	for _, cell := range row {
+		if str := cell.(string); str != "" {
+			if len(str) <= 4 || !strings.HasPrefix(str, "**") || !strings.HasSuffix(str, "**") {
+				return false
+			}
+			numBold++
+		}
+	}
+	return numBold > 0
+}
+
+// parseMarkdownRow returns the values of the cells in the row.
+func parseMarkdownRow(line string) Row {
+	var row Row
+	var cell strings.Builder
+	runes := []rune(line[1 : len(line)-1])
+	for idx := 0; idx < len(runes); idx++ {
+		switch {
+		case runes[idx] == '\\' && idx+1 < len(runes):
+			// keep the escaped characters (including pipes) within the cell
+			cell.WriteRune(runes[idx])
+			cell.WriteRune(runes[idx+1])
+			idx++
+		case runes[idx] == '|':
+			row = append(row, parseMarkdownUnescape(cell.String()))
+			cell.Reset()
+		default:
+			cell.WriteRune(runes[idx])
+		}
+	}
+	return append(row, parseMarkdownUnescape(cell.String()))
+}
+
+// parseMarkdownUnescape returns the text after reverting the escaping done
+// for the special characters and new-lines.
+func parseMarkdownUnescape(str string) string {
+	var out strings.Builder
+	runes := []rune(strings.TrimSpace(str))
+	for idx := 0; idx < len(runes); idx++ {
+		if runes[idx] == '\\' && idx+1 < len(runes) && strings.ContainsRune("\\`|", runes[idx+1]) {
+			idx++
+		}
+		out.WriteRune(runes[idx])
+	}
+	return html.UnescapeString(markdownNewlineRegex.ReplaceAllString(out.String(), "\n"))
+}
@@ -0,0 +1,79 @@
+package table
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseMarkdown(t *testing.T) {
+	tw := NewWriter()
+	tw.AppendHeader(testHeader)
+	tw.AppendRows(testRows)
+	tw.AppendRow(testRowNewLines)
+	tw.AppendRow(testRowPipes)
+	tw.AppendFooter(testFooter)
+	tw.SetCaption(testCaption)
+	tw.SetTitle(testTitle1)
+
+	parsed, err := ParseMarkdown(tw.RenderMarkdown())
+	if assert.Nil(t, err) {
+		assert.Equal(t, testTitle1, parsed.Title)
+		assert.Equal(t, []Row{{"#", "First Name", "Last Name", "Salary", ""}}, parsed.Header)
+		assert.Equal(t, []Row{
+			{"1", "Arya", "Stark", "3000", ""},
+			{"20", "Jon", "Snow", "2000", "You know nothing, Jon Snow!"},
+			{"300", "Tyrion", "Lannister", "5000", ""},
+			{"0", "Valar", "Morghulis", "0", "Faceless\nMen"},
+			{"0", "Valar", "Morghulis", "0", "Faceless|Men"},
+			{"", "", "Total", "10000", ""},
+		}, parsed.Rows)
+		assert.Empty(t, parsed.Footer)
+		assert.Equal(t, testCaption, parsed.Caption)
+	}
+}
+
+func TestParseMarkdown_AutoIndex(t *testing.T) {
+	tw := NewWriter()
+	tw.AppendRow(Row{"Arya", "Stark"})
+	tw.AppendRow(Row{"Jon", "Snow"})
+	tw.SetAutoIndex(true)
+
+	parsed, err := ParseMarkdown(tw.RenderMarkdown())
+	if assert.Nil(t, err) {
+		assert.Empty(t, parsed.Title)
+		assert.Equal(t, []Row{{"", "A", "B"}}, parsed.Header)
+		assert.Equal(t, []Row{{"1", "Arya", "Stark"}, {"2", "Jon", "Snow"}}, parsed.Rows)
+		assert.Empty(t, parsed.Caption)
+	}
+}
+
+func TestParseMarkdown_NoTable(t *testing.T) {
+	parsed, err := ParseMarkdown("# Game of Thrones")
+	assert.Nil(t, parsed)
+	assert.NotNil(t, err)
+}
+
+func TestParseMarkdown_Options(t *testing.T) {
+	tw := NewWriter()
+	tw.AppendHeader(Row{"Code", "HTML"})
+	tw.AppendRow(Row{"`a|b` \\ c", "<b>Jon & Arya</b>\nStark"})
+	tw.AppendFooter(Row{"", "Total"})
+	tw.SetTitle("<Game> of `Thrones`")
+	tw.SetCaption("A Song of Ice & Fire")
+	tw.Style().Markdown = MarkdownOptions{
+		BoldFooter: true,
+		EscapeText: true,
+		Newline:    "<br>",
+		PadColumns: true,
+	}
+
+	parsed, err := ParseMarkdown(tw.RenderMarkdown())
+	if assert.Nil(t, err) {
+		assert.Equal(t, "<Game> of `Thrones`", parsed.Title)
+		assert.Equal(t, []Row{{"Code", "HTML"}}, parsed.Header)
+		assert.Equal(t, []Row{{"`a|b` \\ c", "<b>Jon & Arya</b>\nStark"}}, parsed.Rows)
+		assert.Equal(t, []Row{{"", "Total"}}, parsed.Footer)
+		assert.Equal(t, "A Song of Ice & Fire", parsed.Caption)
+	}
+}
@@ -0,0 +1,185 @@
+package table
+
+import (
+	"testing"
+
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	expectedHeader := []Row{{"#", "FIRST NAME", "LAST NAME", "SALARY", ""}}
+	expectedRows := []Row{
+		{"1", "Arya", "Stark", "3000", ""},
+		{"20", "Jon", "Snow", "2000", "You know nothing, Jon Snow!"},
+		{"300", "Tyrion", "Lannister", "5000", ""},
+	}
+	expectedFooter := []Row{{"", "", "TOTAL", "10000", ""}}
+
+	for _, style := range []Style{StyleDefault, StyleBold, StyleDouble, StyleLight, StyleRounded} {
+		tw := NewWriter()
+		tw.AppendHeader(testHeader)
+		tw.AppendRows(testRows)
+		tw.AppendFooter(testFooter)
+		tw.SetCaption(testCaption)
+		tw.SetTitle(testTitle1)
+		tw.SetStyle(style)
+
+		parsed, err := Parse(tw.Render())
+		if assert.Nil(t, err, style.Name) {
+			assert.Equal(t, testTitle1, parsed.Title, style.Name)
+			assert.Equal(t, expectedHeader, parsed.Header, style.Name)
+			assert.Equal(t, expectedRows, parsed.Rows, style.Name)
+			assert.Equal(t, expectedFooter, parsed.Footer, style.Name)
+			assert.Equal(t, testCaption, parsed.Caption, style.Name)
+		}
+	}
+}
+
+func TestParse_AllStyles(t *testing.T) {
+	rows := append(append([]Row{}, testRows...), Row{4, "Needle | Sword", "Stark", 1})
+	expectedHeader := []Row{{"#", "FIRST NAME", "LAST NAME", "SALARY", ""}}
+	expectedRows := []Row{
+		{"1", "Arya", "Stark", "3000", ""},
+		{"20", "Jon", "Snow", "2000", "You know nothing, Jon Snow!"},
+		{"300", "Tyrion", "Lannister", "5000", ""},
+		{"4", "Needle | Sword", "Stark", "1", ""},
+	}
+	expectedFooter := []Row{{"", "", "TOTAL", "10000", ""}}
+
+	for _, name := range StyleNames() {
+		style, _ := StyleByName(name)
+		tw := NewWriter()
+		tw.AppendHeader(testHeader)
+		tw.AppendRows(rows)
+		tw.AppendFooter(testFooter)
+		tw.SetStyle(style)
+
+		parsed, err := Parse(tw.Render())
+		if !assert.Nil(t, err, name) {
+			continue
+		}
+		if style.Options.SeparateColumns {
+			assert.Equal(t, expectedHeader, parsed.Header, name)
+			assert.Equal(t, expectedRows, parsed.Rows, name)
+			assert.Equal(t, expectedFooter, parsed.Footer, name)
+		} else {
+			// without any box characters, every line is a row with one column
+			assert.Empty(t, parsed.Header, name)
+			if assert.Len(t, parsed.Rows, 6, name) {
+				assert.Equal(t, Row{"20  Jon             Snow         2000  You know nothing, Jon Snow!"}, parsed.Rows[2], name)
+				assert.Equal(t, Row{"4  Needle | Sword  Stark           1"}, parsed.Rows[4], name)
+			}
+			assert.Empty(t, parsed.Footer, name)
+		}
+
+		// without any separator lines, the columns are found using the
+		// vertical lines in all the lines of the table
+		tw.Style().Options.DrawBorder = false
+		tw.Style().Options.SeparateHeader = false
+		tw.Style().Options.SeparateFooter = false
+		parsed, err = Parse(tw.Render())
+		if assert.Nil(t, err, name) && style.Options.SeparateColumns {
+			assert.Empty(t, parsed.Header, name)
+			assert.Equal(t, append(append(expectedHeader, expectedRows...), expectedFooter...), parsed.Rows, name)
+			assert.Empty(t, parsed.Footer, name)
+		}
+	}
+}
+
+func TestParse_AutoMerge(t *testing.T) {
+	tw := NewWriter()
+	tw.AppendHeader(Row{"Node", "Node", "Status"}, RowConfig{AutoMerge: true})
+	tw.AppendRow(Row{"1.1.1.1", "Pod 1A", "Y"})
+	tw.AppendRow(Row{"1.1.1.1", "Pod 1B", "Y"})
+	tw.AppendRow(Row{"2.2.2.2", "Pod 2A", "N"})
+	tw.SetColumnConfigs([]ColumnConfig{{Number: 1, AutoMerge: true}})
+	tw.SetStyle(StyleLight)
+	tw.Style().Options.SeparateRows = true
+
+	parsed, err := Parse(tw.Render())
+	if assert.Nil(t, err) {
+		assert.Equal(t, []Row{{"NODE", "NODE", "STATUS"}}, parsed.Header)
+		assert.Equal(t, []Row{
+			{"1.1.1.1", "Pod 1A", "Y"},
+			{"", "Pod 1B", "Y"},
+		}, parsed.Rows)
+		assert.Equal(t, []Row{{"2.2.2.2", "Pod 2A", "N"}}, parsed.Footer)
+	}
+}
+
+func TestParse_Colored(t *testing.T) {
+	tw := NewWriter()
+	tw.AppendHeader(Row{"Name", "Weapon"})
+	tw.AppendRow(Row{text.FgRed.Sprint("Arya"), "Needle | Sword"})
+	tw.AppendRow(Row{"ツ", "-"})
+
+	parsed, err := Parse(tw.Render())
+	if assert.Nil(t, err) {
+		assert.Empty(t, parsed.Title)
+		assert.Equal(t, []Row{{"NAME", "WEAPON"}}, parsed.Header)
+		assert.Equal(t, []Row{{"Arya", "Needle | Sword"}, {"ツ", "-"}}, parsed.Rows)
+		assert.Empty(t, parsed.Footer)
+		assert.Empty(t, parsed.Caption)
+	}
+}
+
+func TestParse_NoBorder(t *testing.T) {
+	tw := NewWriter()
+	tw.AppendHeader(testHeader)
+	tw.AppendRows(testRows)
+	tw.AppendFooter(testFooter)
+	tw.SetCaption(testCaption)
+	tw.SetTitle(testTitle1)
+	tw.Style().Options.DrawBorder = false
+
+	parsed, err := Parse(tw.Render())
+	if assert.Nil(t, err) {
+		assert.Equal(t, testTitle1, parsed.Title)
+		assert.Equal(t, []Row{{"#", "FIRST NAME", "LAST NAME", "SALARY", ""}}, parsed.Header)
+		assert.Len(t, parsed.Rows, 3)
+		assert.Equal(t, Row{"20", "Jon", "Snow", "2000", "You know nothing, Jon Snow!"}, parsed.Rows[1])
+		assert.Equal(t, []Row{{"", "", "TOTAL", "10000", ""}}, parsed.Footer)
+		assert.Equal(t, testCaption, parsed.Caption)
+	}
+
+	tw.Style().Options.SeparateHeader = false
+	tw.Style().Options.SeparateFooter = false
+	parsed, err = Parse(tw.Render())
+	if assert.Nil(t, err) {
+		assert.Equal(t, testTitle1, parsed.Title)
+		assert.Empty(t, parsed.Header)
+		assert.Len(t, parsed.Rows, 5)
+		assert.Equal(t, Row{"300", "Tyrion", "Lannister", "5000", ""}, parsed.Rows[3])
+		assert.Equal(t, testCaption, parsed.Caption)
+	}
+}
+
+func TestParse_NoTable(t *testing.T) {
+	parsed, err := Parse("")
+	assert.Nil(t, parsed)
+	assert.NotNil(t, err)
+}
+
+func TestParsedTable_Writer(t *testing.T) {
+	tw := NewWriter()
+	tw.AppendHeader(testHeader)
+	tw.AppendRows(testRows)
+	tw.AppendFooter(testFooter)
+	tw.SetCaption(testCaption)
+	tw.SetTitle(testTitle1)
+	tw.SetStyle(StyleLight)
+	out := tw.Render()
+
+	parsed, err := Parse(out)
+	if assert.Nil(t, err) {
+		tw2 := parsed.Writer()
+		tw2.SetStyle(StyleLight)
+		// the values are all strings, and so the numbers need to be aligned
+		tw2.SetColumnConfigs([]ColumnConfig{
+			{Number: 1, Align: text.AlignRight, AlignHeader: text.AlignRight},
+			{Number: 4, Align: text.AlignRight, AlignFooter: text.AlignRight},
+		})
+		assert.Equal(t, out, tw2.Render())
+	}
+}
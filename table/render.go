@@ -19,26 +19,35 @@ import (
 //  │     │            │ TOTAL     │  10000 │                             │
 //  └─────┴────────────┴───────────┴────────┴─────────────────────────────┘
 func (t *Table) Render() string {
+	var out strings.Builder
+	t.renderText(&out)
+	return t.render(&out)
+}
+
+func (t *Table) renderText(out *strings.Builder) {
+	t.renderCharts = true
+	defer func() {
+		t.renderCharts = false
+	}()
 	t.initForRender()
 
-	var out strings.Builder
 	if t.numColumns > 0 {
-		t.renderTitle(&out)
+		t.renderTitle(out)
 
 		// top-most border
-		t.renderRowsBorderTop(&out)
+		t.renderRowsBorderTop(out)
 
 		// header rows
-		t.renderRowsHeader(&out)
+		t.renderRowsHeader(out)
 
 		// (data) rows
-		t.renderRows(&out, t.rows, renderHint{})
+		t.renderRows(out, t.rows, renderHint{})
 
 		// footer rows
-		t.renderRowsFooter(&out)
+		t.renderRowsFooter(out)
 
 		// bottom-most border
-		t.renderRowsBorderBottom(&out)
+		t.renderRowsBorderBottom(out)
 
 		// caption
 		if t.caption != "" {
@@ -46,7 +55,6 @@ func (t *Table) Render() string {
 			out.WriteString(t.caption)
 		}
 	}
-	return t.render(&out)
 }
 
 func (t *Table) renderColumn(out *strings.Builder, row rowStr, colIdx int, maxColumnLength int, hint renderHint) int {
@@ -129,7 +137,7 @@ func (t *Table) renderColumnAutoIndex(out *strings.Builder, hint renderHint) {
 		if hint.isFooterRow {
 			colors = t.style.Color.Footer
 		}
-		out.WriteString(colors.Sprint(outAutoIndex.String()))
+		out.WriteString(colors.SprintProfile(t.getColorProfile(), outAutoIndex.String()))
 	} else {
 		out.WriteString(outAutoIndex.String())
 	}
@@ -139,22 +147,15 @@ func (t *Table) renderColumnAutoIndex(out *strings.Builder, hint renderHint) {
 
 func (t *Table) renderColumnColorized(out *strings.Builder, colIdx int, colStr string, hint renderHint) {
 	colors := t.getColumnColors(colIdx, hint)
+	if colors == nil {
+		colors = t.getRowColors(colIdx, hint)
+	}
+	if colorsByRules := t.getColumnColorsByRules(colIdx, hint); len(colorsByRules) > 0 {
+		colors = append(append(text.Colors{}, colors...), colorsByRules...)
+	}
+
 	if colors != nil {
-		out.WriteString(colors.Sprint(colStr))
-	} else if hint.isHeaderRow && t.style.Color.Header != nil {
-		out.WriteString(t.style.Color.Header.Sprint(colStr))
-	} else if hint.isFooterRow && t.style.Color.Footer != nil {
-		out.WriteString(t.style.Color.Footer.Sprint(colStr))
-	} else if hint.isRegularRow() {
-		if colIdx == t.indexColumn-1 && t.style.Color.IndexColumn != nil {
-			out.WriteString(t.style.Color.IndexColumn.Sprint(colStr))
-		} else if hint.rowNumber%2 == 0 && t.style.Color.RowAlternate != nil {
-			out.WriteString(t.style.Color.RowAlternate.Sprint(colStr))
-		} else if t.style.Color.Row != nil {
-			out.WriteString(t.style.Color.Row.Sprint(colStr))
-		} else {
-			out.WriteString(colStr)
-		}
+		out.WriteString(colors.SprintProfile(t.getColorProfile(), colStr))
 	} else {
 		out.WriteString(colStr)
 	}
@@ -166,7 +167,7 @@ func (t *Table) renderColumnSeparator(out *strings.Builder, row rowStr, colIdx i
 
 		colors := t.getSeparatorColors(hint)
 		if colors.EscapeSeq() != "" {
-			out.WriteString(colors.Sprint(separator))
+			out.WriteString(colors.SprintProfile(t.getColorProfile(), separator))
 		} else {
 			out.WriteString(separator)
 		}
@@ -252,7 +253,7 @@ func (t *Table) renderMarginLeft(out *strings.Builder, hint renderHint) {
 
 		colors := t.getBorderColors(hint)
 		if colors.EscapeSeq() != "" {
-			out.WriteString(colors.Sprint(border))
+			out.WriteString(colors.SprintProfile(t.getColorProfile(), border))
 		} else {
 			out.WriteString(border)
 		}
@@ -280,7 +281,7 @@ func (t *Table) renderMarginRight(out *strings.Builder, hint renderHint) {
 
 		colors := t.getBorderColors(hint)
 		if colors.EscapeSeq() != "" {
-			out.WriteString(colors.Sprint(border))
+			out.WriteString(colors.SprintProfile(t.getColorProfile(), border))
 		} else {
 			out.WriteString(border)
 		}
@@ -296,7 +297,11 @@ func (t *Table) renderRow(out *strings.Builder, row rowStr, hint renderHint) {
 		rowWrapped := make(rowStr, len(row))
 		for colIdx, colStr := range row {
 			widthEnforcer := t.columnConfigMap[colIdx].getWidthMaxEnforcer()
-			rowWrapped[colIdx] = widthEnforcer(colStr, t.maxColumnLengths[colIdx])
+			widthMax := t.maxColumnLengths[colIdx]
+			if cfgWidthMax := t.getColumnWidthMax(colIdx); cfgWidthMax > 0 && cfgWidthMax < widthMax {
+				widthMax = cfgWidthMax
+			}
+			rowWrapped[colIdx] = widthEnforcer(colStr, widthMax)
 			colNumLines := strings.Count(rowWrapped[colIdx], "\n") + 1
 			if colNumLines > colMaxLines {
 				colMaxLines = colNumLines
@@ -414,7 +419,7 @@ func (t *Table) renderTitle(out *strings.Builder) {
 			titleLine = t.style.Title.Format.Apply(titleLine)
 			titleLine = t.style.Title.Align.Apply(titleLine, lenText)
 			titleLine = t.style.Box.PaddingLeft + titleLine + t.style.Box.PaddingRight
-			titleLine = t.style.Title.Colors.Sprint(titleLine)
+			titleLine = t.style.Title.Colors.SprintProfile(t.getColorProfile(), titleLine)
 
 			if out.Len() > 0 {
 				out.WriteRune('\n')
@@ -138,3 +138,23 @@ func TestTable_RenderCSV_Sorted(t *testing.T) {
 ,,Total,10000,`
 	assert.Equal(t, expectedOut, tw.RenderCSV())
 }
+
+func TestTable_RenderCSV_DataBarsAndSparklines(t *testing.T) {
+	tw := NewWriter()
+	tw.AppendHeader(Row{"Host", "Usage", "Trend"})
+	tw.AppendRows([]Row{
+		{"alpha", 80, []float64{1, 2, 4, 8}},
+		{"beta", 5, []int{3, 3, 3}},
+	})
+	tw.SetColumnConfigs([]ColumnConfig{
+		{Name: "Usage", DataBar: &DataBar{Width: 5}},
+		{Name: "Trend", Sparkline: &Sparkline{}},
+	})
+	assert.Contains(t, tw.Render(), "▁▂▄█")
+
+	// the data bars and sparklines are not rendered in place of the values
+	expectedOut := `Host,Usage,Trend
+alpha,80,[1 2 4 8]
+beta,5,[3 3 3]`
+	assert.Equal(t, expectedOut, tw.RenderCSV())
+}
@@ -3,7 +3,20 @@ package table
 import (
 	"fmt"
 	"html"
+	"math"
+	"strconv"
 	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/text"
+)
+
+const (
+	// htmlChartCharWidth is the width (in px) of each character of a data bar
+	// or a sparkline when rendered as an inline SVG
+	htmlChartCharWidth = 8
+	// htmlChartHeight is the height (in px) of a data bar or a sparkline when
+	// rendered as an inline SVG
+	htmlChartHeight = 12
 )
 
 const (
@@ -57,25 +70,41 @@ const (
 //    </tfoot>
 //  </table>
 func (t *Table) RenderHTML() string {
+	var out strings.Builder
+	t.htmlRender(&out, t.htmlGetCSSClass())
+	return t.render(&out)
+}
+
+func (t *Table) htmlRender(out *strings.Builder, cssClass string) {
+	t.renderCharts = true
+	defer func() {
+		t.renderCharts = false
+	}()
 	t.initForRender()
 
-	var out strings.Builder
 	if t.numColumns > 0 {
 		out.WriteString("<table class=\"")
-		if t.htmlCSSClass != "" {
-			out.WriteString(t.htmlCSSClass)
-		} else {
-			out.WriteString(t.style.HTML.CSSClass)
-		}
-		out.WriteString("\">\n")
-		t.htmlRenderTitle(&out)
-		t.htmlRenderRowsHeader(&out)
-		t.htmlRenderRows(&out, t.rows, renderHint{})
-		t.htmlRenderRowsFooter(&out)
-		t.htmlRenderCaption(&out)
+		out.WriteString(cssClass)
+		out.WriteRune('"')
+		if t.style.HTML.InlineCSS {
+			htmlRenderStyle(out, t.style.cssTable())
+		}
+		out.WriteString(">\n")
+		t.htmlRenderTitle(out)
+		t.htmlRenderRowsHeader(out)
+		t.htmlRenderRows(out, t.rows, renderHint{})
+		t.htmlRenderRowsFooter(out)
+		t.htmlRenderCaption(out)
 		out.WriteString("</table>")
 	}
-	return t.render(&out)
+}
+
+// htmlGetCSSClass returns the CSS class to use on the <table> tag.
+func (t *Table) htmlGetCSSClass() string {
+	if t.htmlCSSClass != "" {
+		return t.htmlCSSClass
+	}
+	return t.Style().HTML.CSSClass
 }
 
 func (t *Table) htmlRenderCaption(out *strings.Builder) {
@@ -92,6 +121,23 @@ func (t *Table) htmlRenderColumnAttributes(out *strings.Builder, row rowStr, col
 	vAlign := t.getVAlign(colIdx, hint).HTMLProperty()
 	// determine the HTML "class" property values for the colors
 	class := t.getColumnColors(colIdx, hint).HTMLProperty()
+	// determine the HTML "style" property values for the color rules
+	style := t.getColumnColorsByRules(colIdx, hint).CSSStyle()
+	if t.style.HTML.InlineCSS {
+		// inline everything including the colors instead of using classes
+		cellIdx := colIdx
+		if t.autoIndex {
+			cellIdx++
+		}
+		declarations := t.style.cssCell(cellIdx, hint)
+		declarations = append(declarations, cssDeclarations(t.getColumnColors(colIdx, hint).CSSStyle())...)
+		declarations = append(declarations, cssDeclarations(style)...)
+		class, style = "", strings.Join(declarations, "; ")
+	} else if strings.Contains(class, "style=\"") && style != "" {
+		// merge with the inline style used for extended (256/RGB) colors
+		class = class[:len(class)-1] + "; " + style + "\""
+		style = ""
+	}
 
 	if align != "" {
 		out.WriteRune(' ')
@@ -105,26 +151,63 @@ func (t *Table) htmlRenderColumnAttributes(out *strings.Builder, row rowStr, col
 		out.WriteRune(' ')
 		out.WriteString(vAlign)
 	}
+	if style != "" {
+		out.WriteString(" style=\"")
+		out.WriteString(style)
+		out.WriteRune('"')
+	}
 }
 
 func (t *Table) htmlRenderColumnAutoIndex(out *strings.Builder, hint renderHint) {
+	var style []string
+	if t.style.HTML.InlineCSS {
+		style = t.style.cssCell(0, hint)
+	}
+
 	if hint.isHeaderRow {
-		out.WriteString("    <th>")
+		out.WriteString("    <th")
+		htmlRenderStyle(out, style)
+		out.WriteString(">")
 		out.WriteString(t.style.HTML.EmptyColumn)
 		out.WriteString("</th>\n")
 	} else if hint.isFooterRow {
-		out.WriteString("    <td>")
+		out.WriteString("    <td")
+		htmlRenderStyle(out, style)
+		out.WriteString(">")
 		out.WriteString(t.style.HTML.EmptyColumn)
 		out.WriteString("</td>\n")
 	} else {
-		out.WriteString("    <td align=\"right\">")
+		out.WriteString("    <td align=\"right\"")
+		htmlRenderStyle(out, style)
+		out.WriteString(">")
 		out.WriteString(fmt.Sprint(hint.rowNumber))
 		out.WriteString("</td>\n")
 	}
 }
 
+func (t *Table) htmlEscape(str string) string {
+	if t.style.HTML.EscapeText {
+		str = html.EscapeString(str)
+	}
+	if t.style.HTML.ConvertANSI {
+		if t.style.HTML.InlineCSS {
+			str = text.ANSIToHTMLInline(str)
+		} else {
+			str = text.ANSIToHTML(str)
+		}
+	}
+	if t.style.HTML.Newline != "\n" {
+		str = strings.Replace(str, "\n", t.style.HTML.Newline, -1)
+	}
+	return text.ReplaceHyperlinks(str, text.HTMLHyperlink)
+}
+
 func (t *Table) htmlRenderRow(out *strings.Builder, row rowStr, hint renderHint) {
-	out.WriteString("  <tr>\n")
+	out.WriteString("  <tr")
+	if t.style.HTML.InlineCSS {
+		htmlRenderStyle(out, t.style.cssRow(hint))
+	}
+	out.WriteString(">\n")
 	for colIdx := 0; colIdx < t.numColumns; colIdx++ {
 		// auto-index column
 		if colIdx == 0 && t.autoIndex {
@@ -148,16 +231,12 @@ func (t *Table) htmlRenderRow(out *strings.Builder, row rowStr, hint renderHint)
 		out.WriteString(colTagName)
 		t.htmlRenderColumnAttributes(out, row, colIdx, hint)
 		out.WriteString(">")
-		if len(colStr) == 0 {
+		if colHTML := t.getColumnHTML(colIdx, hint); colHTML != "" {
+			out.WriteString(colHTML)
+		} else if len(colStr) == 0 {
 			out.WriteString(t.style.HTML.EmptyColumn)
 		} else {
-			if t.style.HTML.EscapeText {
-				colStr = html.EscapeString(colStr)
-			}
-			if t.style.HTML.Newline != "\n" {
-				colStr = strings.Replace(colStr, "\n", t.style.HTML.Newline, -1)
-			}
-			out.WriteString(colStr)
+			out.WriteString(t.htmlEscape(colStr))
 		}
 		out.WriteString("</")
 		out.WriteString(colTagName)
@@ -179,6 +258,8 @@ func (t *Table) htmlRenderRows(out *strings.Builder, rows []rowStr, hint renderH
 		var renderedTagOpen, shouldRenderTagClose bool
 		for idx, row := range rows {
 			hint.rowNumber = idx + 1
+			hint.isFirstRow = !renderedTagOpen
+			hint.isLastRow = idx == len(rows)-1
 			if len(row) > 0 {
 				if !renderedTagOpen {
 					out.WriteString("  <")
@@ -219,7 +300,21 @@ func (t *Table) htmlRenderTitle(out *strings.Builder) {
 		colors := t.style.Title.Colors.HTMLProperty()
 		title := t.style.Title.Format.Apply(t.title)
 
-		out.WriteString("  <caption class=\"title\"")
+		// merge the CSS classes for the colors (if any) into the "class"
+		// property; whatever remains is the "style" for extended colors
+		class := "title"
+		if t.style.HTML.InlineCSS {
+			colors = ""
+		} else if strings.HasPrefix(colors, "class=\"") {
+			classes := strings.TrimPrefix(colors, "class=\"")
+			idx := strings.Index(classes, "\"")
+			class += " " + classes[:idx]
+			colors = strings.TrimSpace(classes[idx+1:])
+		}
+
+		out.WriteString("  <caption class=\"")
+		out.WriteString(class)
+		out.WriteRune('"')
 		if align != "" {
 			out.WriteRune(' ')
 			out.WriteString(align)
@@ -228,8 +323,95 @@ func (t *Table) htmlRenderTitle(out *strings.Builder) {
 			out.WriteRune(' ')
 			out.WriteString(colors)
 		}
+		if t.style.HTML.InlineCSS {
+			htmlRenderStyle(out, cssDeclarations(t.style.Title.Colors.CSSStyle()))
+		}
 		out.WriteRune('>')
 		out.WriteString(title)
 		out.WriteString("</caption>\n")
 	}
 }
+
+// htmlDataBar returns an inline SVG with a bar filling up the given fraction
+// of the width.
+func htmlDataBar(fraction float64, dataBar *DataBar) string {
+	width := float64(dataBar.getWidth() * htmlChartCharWidth)
+	if math.IsNaN(fraction) {
+		fraction = 0
+	}
+	fraction = math.Max(0, math.Min(1, fraction))
+
+	var out strings.Builder
+	out.WriteString("<svg class=\"data-bar\"")
+	htmlRenderChartStyle(&out, dataBar.Colors)
+	out.WriteString(fmt.Sprintf(" width=\"%s\" height=\"%d\">", htmlFormatNumber(width), htmlChartHeight))
+	out.WriteString(fmt.Sprintf("<rect width=\"%s\" height=\"%d\" fill=\"currentColor\"/>", htmlFormatNumber(fraction*width), htmlChartHeight))
+	out.WriteString("</svg>")
+	return out.String()
+}
+
+// htmlSparkline returns an inline SVG with a line joining the given values
+// scaled between the smallest and the largest of them. NaN and infinite values
+// leave a gap in the line.
+func htmlSparkline(values []float64, sparkline *Sparkline) string {
+	min, max := math.Inf(1), math.Inf(-1)
+	for _, value := range values {
+		if isFinite(value) {
+			min, max = math.Min(min, value), math.Max(max, value)
+		}
+	}
+
+	var lines [][]string
+	var points []string
+	for idx, value := range values {
+		if !isFinite(value) {
+			if len(points) > 0 {
+				lines = append(lines, points)
+				points = nil
+			}
+			continue
+		}
+		fraction := 0.0
+		if max > min {
+			fraction = (value - min) / (max - min)
+		}
+		x := float64(idx*htmlChartCharWidth + htmlChartCharWidth/2)
+		y := float64(htmlChartHeight-1) - fraction*float64(htmlChartHeight-2)
+		points = append(points, htmlFormatNumber(x)+","+htmlFormatNumber(y))
+	}
+	if len(points) > 0 {
+		lines = append(lines, points)
+	}
+
+	var out strings.Builder
+	out.WriteString("<svg class=\"sparkline\"")
+	htmlRenderChartStyle(&out, sparkline.Colors)
+	out.WriteString(fmt.Sprintf(" width=\"%d\" height=\"%d\">", len(values)*htmlChartCharWidth, htmlChartHeight))
+	for _, line := range lines {
+		out.WriteString("<polyline fill=\"none\" stroke=\"currentColor\" points=\"")
+		out.WriteString(strings.Join(line, " "))
+		out.WriteString("\"/>")
+	}
+	out.WriteString("</svg>")
+	return out.String()
+}
+
+func htmlFormatNumber(number float64) string {
+	return strconv.FormatFloat(math.Round(number*100)/100, 'f', -1, 64)
+}
+
+func htmlRenderStyle(out *strings.Builder, declarations []string) {
+	if len(declarations) > 0 {
+		out.WriteString(" style=\"")
+		out.WriteString(strings.Join(declarations, "; "))
+		out.WriteRune('"')
+	}
+}
+
+func htmlRenderChartStyle(out *strings.Builder, colors text.Colors) {
+	if style := colors.CSSStyle(); style != "" {
+		out.WriteString(" style=\"")
+		out.WriteString(style)
+		out.WriteRune('"')
+	}
+}
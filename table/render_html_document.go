@@ -0,0 +1,237 @@
+package table
+
+import (
+	"fmt"
+	"html"
+	"strings"
+)
+
+const (
+	// htmlDocumentCSS is the CSS embedded in every HTML document before the
+	// stylesheets of the Tables in it
+	htmlDocumentCSS = `body { font-family: sans-serif; }
+table { margin: 1em 0; }
+input.go-pretty-filter { display: block; margin: 1em 0 0 0; }
+th[aria-sort="ascending"]::after { content: " \25B2"; }
+th[aria-sort="descending"]::after { content: " \25BC"; }
+`
+
+	// htmlDocumentJSFilter is the JS embedded in the HTML document to insert a
+	// filter box above every table that hides the rows not matching the text
+	htmlDocumentJSFilter = `(function () {
+  Array.prototype.forEach.call(document.querySelectorAll("table"), function (table) {
+    if (!table.tBodies.length) {
+      return;
+    }
+    var input = document.createElement("input");
+    input.type = "search";
+    input.className = "go-pretty-filter";
+    input.placeholder = "Filter...";
+    input.addEventListener("input", function () {
+      var query = input.value.toLowerCase();
+      Array.prototype.forEach.call(table.tBodies, function (tbody) {
+        Array.prototype.forEach.call(tbody.rows, function (row) {
+          row.style.display = row.textContent.toLowerCase().indexOf(query) >= 0 ? "" : "none";
+        });
+      });
+    });
+    table.parentNode.insertBefore(input, table);
+  });
+})();
+`
+
+	// htmlDocumentJSSort is the JS embedded in the HTML document to sort the
+	// rows of a table by clicking on a column header; numbers are compared
+	// numerically and everything else as text
+	htmlDocumentJSSort = `(function () {
+  function toNumber(value) {
+    value = value.replace(/[,\s]/g, "");
+    return value !== "" && !isNaN(value) ? Number(value) : null;
+  }
+  function compare(a, b) {
+    var x = toNumber(a), y = toNumber(b);
+    if (x !== null && y !== null) {
+      return x - y;
+    }
+    return a.localeCompare(b);
+  }
+  function cellText(row, idx) {
+    var cell = row.cells[idx];
+    return cell ? cell.textContent.trim() : "";
+  }
+  Array.prototype.forEach.call(document.querySelectorAll("table"), function (table) {
+    if (!table.tHead || !table.tHead.rows.length) {
+      return;
+    }
+    var headers = table.tHead.rows[table.tHead.rows.length - 1].cells;
+    Array.prototype.forEach.call(headers, function (th, idx) {
+      th.style.cursor = "pointer";
+      th.addEventListener("click", function () {
+        var ascending = th.getAttribute("aria-sort") !== "ascending";
+        Array.prototype.forEach.call(headers, function (header) {
+          header.removeAttribute("aria-sort");
+        });
+        th.setAttribute("aria-sort", ascending ? "ascending" : "descending");
+        Array.prototype.forEach.call(table.tBodies, function (tbody) {
+          var rows = Array.prototype.slice.call(tbody.rows);
+          rows.sort(function (a, b) {
+            var result = compare(cellText(a, idx), cellText(b, idx));
+            return ascending ? result : -result;
+          });
+          rows.forEach(function (row) {
+            tbody.appendChild(row);
+          });
+        });
+      });
+    });
+  });
+})();
+`
+)
+
+// HTMLDocumentOptions defines the options to control the rendering of a
+// complete HTML document using RenderHTMLDocument.
+type HTMLDocumentOptions struct {
+	CSS        string // additional CSS to embed after the generated stylesheet
+	Filterable bool   // embed JS to insert a box above every table to filter the rows?
+	Sortable   bool   // embed JS to sort the rows by clicking on the column headers?
+	Title      string // title of the document (in the <title> tag)
+}
+
+// HTMLRenderer is anything that can render itself in the HTML format, like a
+// Table or a list.List.
+type HTMLRenderer interface {
+	RenderHTML() string
+}
+
+// RenderHTMLDocument renders the Table (see RenderHTML) into a complete
+// self-contained HTML document with the stylesheet for the Style of the Table
+// (see Style.CSS) embedded in it. Use the package-level function
+// RenderHTMLDocument to render more than one Table (and/or List) into a single
+// document.
+func (t *Table) RenderHTMLDocument(options HTMLDocumentOptions) string {
+	var out strings.Builder
+	htmlRenderDocument(&out, options, t)
+	return t.render(&out)
+}
+
+// RenderHTMLDocument renders the given contents (Tables, Lists, etc.) in the
+// HTML format one after the other into a complete self-contained HTML document
+// that needs no external assets, and so works offline or as an e-mail
+// attachment. The stylesheet for the Style of every Table (see Style.CSS) is
+// embedded in the document, along with the (optional) JS to sort and filter
+// the tables. For ex.:
+//  <!DOCTYPE html>
+//  <html>
+//  <head>
+//  <meta charset="utf-8">
+//  <title>Game of Thrones</title>
+//  <style>
+//  body { font-family: sans-serif; }
+//  ...
+//  table.go-pretty-table { border-collapse: collapse; border: 1px solid; }
+//  ...
+//  </style>
+//  </head>
+//  <body>
+//  <table class="go-pretty-table">
+//  ...
+//  </table>
+//  <ul class="go-pretty-table">
+//  ...
+//  </ul>
+//  </body>
+//  </html>
+//
+// Tables with different Styles using the default CSS class (see
+// DefaultHTMLCSSClass) get unique CSS classes like "go-pretty-table-2" so that
+// their stylesheets do not clash.
+//
+// Contents other than Tables get rendered using their RenderHTML function and
+// so get mirrored to their output mirror (if any) as well.
+func RenderHTMLDocument(options HTMLDocumentOptions, contents ...HTMLRenderer) string {
+	var out strings.Builder
+	htmlRenderDocument(&out, options, contents...)
+	return out.String()
+}
+
+func htmlRenderDocument(out *strings.Builder, options HTMLDocumentOptions, contents ...HTMLRenderer) {
+	// render the contents first to collect the stylesheets of the Tables
+	body := make([]string, 0, len(contents))
+	stylesheets := []string{htmlDocumentCSS}
+	stylesheetsRendered := map[string]bool{}
+	defaultCSSClasses := map[string]string{}
+	for _, content := range contents {
+		if t, ok := content.(*Table); ok {
+			style := *t.Style()
+			style.HTML.CSSClass = t.htmlGetCSSClass()
+			if style.HTML.CSSClass == "" || style.HTML.CSSClass == DefaultHTMLCSSClass {
+				style.HTML.CSSClass = htmlDocumentCSSClass(defaultCSSClasses, style)
+			}
+
+			var tableOut strings.Builder
+			t.htmlRender(&tableOut, style.HTML.CSSClass)
+			body = append(body, t.getColorProfile().Convert(tableOut.String()))
+
+			if css := style.CSS(); !stylesheetsRendered[css] {
+				stylesheets = append(stylesheets, css)
+				stylesheetsRendered[css] = true
+			}
+		} else if content != nil {
+			body = append(body, content.RenderHTML())
+		}
+	}
+	if options.CSS != "" {
+		stylesheets = append(stylesheets, strings.TrimSuffix(options.CSS, "\n")+"\n")
+	}
+
+	out.WriteString("<!DOCTYPE html>\n<html>\n<head>\n<meta charset=\"utf-8\">\n")
+	if options.Title != "" {
+		out.WriteString("<title>")
+		out.WriteString(html.EscapeString(options.Title))
+		out.WriteString("</title>\n")
+	}
+	out.WriteString("<style>\n")
+	for _, stylesheet := range stylesheets {
+		out.WriteString(stylesheet)
+	}
+	out.WriteString("</style>\n</head>\n<body>\n")
+	for _, content := range body {
+		if content != "" {
+			out.WriteString(content)
+			out.WriteRune('\n')
+		}
+	}
+	if options.Filterable || options.Sortable {
+		out.WriteString("<script>\n")
+		if options.Filterable {
+			out.WriteString(htmlDocumentJSFilter)
+		}
+		if options.Sortable {
+			out.WriteString(htmlDocumentJSSort)
+		}
+		out.WriteString("</script>\n")
+	}
+	out.WriteString("</body>\n</html>")
+}
+
+// htmlDocumentCSSClass returns the CSS class for a Table using the default CSS
+// class in a document, so that the stylesheets of differently styled Tables do
+// not override each other. The first Style gets DefaultHTMLCSSClass, and every
+// other distinct Style gets it with a numeric suffix (ex.: "go-pretty-table-2").
+// The classes assigned so far are tracked in cssClasses by the stylesheet of
+// the Style.
+func htmlDocumentCSSClass(cssClasses map[string]string, style Style) string {
+	style.HTML.CSSClass = DefaultHTMLCSSClass
+	css := style.CSS()
+	if cssClass, ok := cssClasses[css]; ok {
+		return cssClass
+	}
+
+	cssClass := DefaultHTMLCSSClass
+	if len(cssClasses) > 0 {
+		cssClass = fmt.Sprintf("%s-%d", DefaultHTMLCSSClass, len(cssClasses)+1)
+	}
+	cssClasses[css] = cssClass
+	return cssClass
+}
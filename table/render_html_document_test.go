@@ -0,0 +1,150 @@
+package table
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/jedib0t/go-pretty/v6/list"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeHTMLRenderer string
+
+func (f fakeHTMLRenderer) RenderHTML() string {
+	return string(f)
+}
+
+func TestTable_RenderHTMLDocument(t *testing.T) {
+	tw := NewWriter()
+	tw.AppendHeader(Row{"#", "Name"})
+	tw.AppendRow(Row{1, "Arya <Stark>"})
+	tw.SetStyle(StyleLight)
+
+	expectedOut := strings.Join([]string{
+		`<!DOCTYPE html>`,
+		`<html>`,
+		`<head>`,
+		`<meta charset="utf-8">`,
+		`<title>Game &amp; Thrones</title>`,
+		`<style>`,
+		htmlDocumentCSS + StyleLight.CSS() + `</style>`,
+		`</head>`,
+		`<body>`,
+		`<table class="go-pretty-table">`,
+		`  <thead>`,
+		`  <tr>`,
+		`    <th align="right">#</th>`,
+		`    <th>Name</th>`,
+		`  </tr>`,
+		`  </thead>`,
+		`  <tbody>`,
+		`  <tr>`,
+		`    <td align="right">1</td>`,
+		`    <td>Arya &lt;Stark&gt;</td>`,
+		`  </tr>`,
+		`  </tbody>`,
+		`</table>`,
+		`</body>`,
+		`</html>`,
+	}, "\n")
+	assert.Equal(t, expectedOut, tw.RenderHTMLDocument(HTMLDocumentOptions{Title: "Game & Thrones"}))
+}
+
+func TestTable_RenderHTMLDocument_Mirror(t *testing.T) {
+	mirror := strings.Builder{}
+
+	tw := NewWriter()
+	tw.AppendRow(Row{1, "Arya"})
+	tw.SetOutputMirror(&mirror)
+	out := tw.RenderHTMLDocument(HTMLDocumentOptions{})
+
+	assert.True(t, strings.HasPrefix(out, "<!DOCTYPE html>\n"))
+	assert.Equal(t, out+"\n", mirror.String())
+}
+
+func TestRenderHTMLDocument(t *testing.T) {
+	tw1 := NewWriter()
+	tw1.AppendRow(Row{1, "Arya"})
+	tw1.SetStyle(StyleDouble)
+	tw2 := NewWriter()
+	tw2.AppendRow(Row{2, "Jon"})
+	tw2.SetStyle(StyleDouble)
+	tw3 := NewWriter()
+	tw3.AppendRow(Row{3, "Tyrion"})
+	tw3.SetStyle(StyleColoredBright)
+	tw3.Style().HTML.CSSClass = "colored"
+	l := list.NewWriter()
+	l.AppendItems([]interface{}{"Winter", "Is", "Coming"})
+
+	out := RenderHTMLDocument(HTMLDocumentOptions{CSS: "body { margin: 0; }"},
+		tw1, l, tw2, tw3, fakeHTMLRenderer("<p>The End</p>"), fakeHTMLRenderer(""))
+
+	assert.True(t, strings.HasPrefix(out, "<!DOCTYPE html>\n<html>\n<head>\n<meta charset=\"utf-8\">\n<style>\n"))
+	assert.True(t, strings.HasSuffix(out, "<p>The End</p>\n</body>\n</html>"))
+	assert.NotContains(t, out, "<title>")
+	assert.NotContains(t, out, "<script>")
+	// stylesheets are embedded once per distinct Style, followed by the custom CSS
+	assert.Equal(t, 1, strings.Count(out, StyleDouble.CSS()))
+	assert.Contains(t, out, "table.colored { border-collapse: collapse; }\n")
+	assert.Contains(t, out, "body { margin: 0; }\n</style>\n")
+	// contents are rendered in order
+	idxTable1 := strings.Index(out, "<td>Arya</td>")
+	idxList := strings.Index(out, "<li>Winter</li>")
+	idxTable2 := strings.Index(out, "<td>Jon</td>")
+	idxTable3 := strings.Index(out, "<table class=\"colored\">")
+	assert.True(t, idxTable1 > 0 && idxTable1 < idxList && idxList < idxTable2 && idxTable2 < idxTable3)
+}
+
+func TestRenderHTMLDocument_DifferentStyles(t *testing.T) {
+	tw1 := NewWriter()
+	tw1.AppendRow(Row{1, "Arya"})
+	tw1.SetStyle(StyleLight)
+	tw2 := NewWriter()
+	tw2.AppendRow(Row{2, "Jon"})
+	tw2.SetStyle(StyleDouble)
+	tw3 := NewWriter()
+	tw3.AppendRow(Row{3, "Tyrion"})
+	tw3.SetStyle(StyleLight)
+	tw4 := NewWriter()
+	tw4.AppendRow(Row{4, "Sansa"})
+	tw4.SetStyle(StyleColoredBright)
+	tw4.Style().HTML.CSSClass = ""
+
+	out := RenderHTMLDocument(HTMLDocumentOptions{}, tw1, tw2, tw3, tw4)
+
+	styleDouble := StyleDouble
+	styleDouble.HTML.CSSClass = DefaultHTMLCSSClass + "-2"
+	styleColoredBright := StyleColoredBright
+	styleColoredBright.HTML.CSSClass = DefaultHTMLCSSClass + "-3"
+	// every distinct Style gets its own CSS class and stylesheet
+	assert.Equal(t, 1, strings.Count(out, StyleLight.CSS()))
+	assert.Equal(t, 1, strings.Count(out, styleDouble.CSS()))
+	assert.Equal(t, 1, strings.Count(out, styleColoredBright.CSS()))
+	assert.Contains(t, out, "<table class=\"go-pretty-table\">\n  <tbody>\n  <tr>\n    <td align=\"right\">1</td>")
+	assert.Contains(t, out, "<table class=\"go-pretty-table-2\">\n  <tbody>\n  <tr>\n    <td align=\"right\">2</td>")
+	assert.Contains(t, out, "<table class=\"go-pretty-table\">\n  <tbody>\n  <tr>\n    <td align=\"right\">3</td>")
+	assert.Contains(t, out, "<table class=\"go-pretty-table-3\">\n  <tbody>\n  <tr")
+	// the Tables themselves are left untouched
+	assert.Equal(t, DefaultHTMLCSSClass, tw2.Style().HTML.CSSClass)
+	assert.Contains(t, tw2.RenderHTML(), "<table class=\"go-pretty-table\">")
+}
+
+func TestRenderHTMLDocument_Scripts(t *testing.T) {
+	tw := NewWriter()
+	tw.AppendHeader(Row{"#", "Name"})
+	tw.AppendRow(Row{1, "Arya"})
+
+	out := RenderHTMLDocument(HTMLDocumentOptions{Filterable: true}, tw)
+	assert.Contains(t, out, "</table>\n<script>\n"+htmlDocumentJSFilter+"</script>\n</body>")
+	assert.NotContains(t, out, htmlDocumentJSSort)
+
+	out = RenderHTMLDocument(HTMLDocumentOptions{Sortable: true}, tw)
+	assert.Contains(t, out, "</table>\n<script>\n"+htmlDocumentJSSort+"</script>\n</body>")
+	assert.NotContains(t, out, htmlDocumentJSFilter)
+
+	out = RenderHTMLDocument(HTMLDocumentOptions{Filterable: true, Sortable: true}, tw)
+	assert.Contains(t, out, "<script>\n"+htmlDocumentJSFilter+htmlDocumentJSSort+"</script>\n")
+	// no external assets
+	assert.NotContains(t, out, "src=")
+	assert.NotContains(t, out, "href=")
+}
@@ -2,7 +2,9 @@ package table
 
 import (
 	"fmt"
+	"math"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/jedib0t/go-pretty/v6/text"
@@ -28,7 +30,7 @@ func TestTable_RenderHTML(t *testing.T) {
 	}
 
 	expectedOut := `<table class="go-pretty-table">
-  <caption class="title" align="left" class="bg-black bold fg-hi-blue">Game Of Thrones</caption>
+  <caption class="title bg-black bold fg-hi-blue" align="left">Game Of Thrones</caption>
   <thead>
   <tr>
     <th align="right">#</th>
@@ -520,3 +522,261 @@ func TestTable_RenderHTML_Sorted(t *testing.T) {
 </table>`
 	assert.Equal(t, expectedOut, tw.RenderHTML())
 }
+
+func TestTable_RenderHTML_ColorRules(t *testing.T) {
+	tw := NewWriter()
+	tw.AppendHeader(testHeader)
+	tw.AppendRows(testRows)
+	tw.SetColumnConfigs([]ColumnConfig{
+		{Name: "Salary", ColorRules: []ColorRule{
+			ColorRuleLessThan(2500, text.Colors{text.FgRed}),
+			ColorRuleMin(text.Colors{text.Bold}),
+		}},
+	})
+
+	expectedOut := `<table class="go-pretty-table">
+  <thead>
+  <tr>
+    <th align="right">#</th>
+    <th>First Name</th>
+    <th>Last Name</th>
+    <th align="right">Salary</th>
+    <th>&nbsp;</th>
+  </tr>
+  </thead>
+  <tbody>
+  <tr>
+    <td align="right">1</td>
+    <td>Arya</td>
+    <td>Stark</td>
+    <td align="right">3000</td>
+    <td>&nbsp;</td>
+  </tr>
+  <tr>
+    <td align="right">20</td>
+    <td>Jon</td>
+    <td>Snow</td>
+    <td align="right" style="color: #cd0000; font-weight: bold">2000</td>
+    <td>You know nothing, Jon Snow!</td>
+  </tr>
+  <tr>
+    <td align="right">300</td>
+    <td>Tyrion</td>
+    <td>Lannister</td>
+    <td align="right">5000</td>
+    <td>&nbsp;</td>
+  </tr>
+  </tbody>
+</table>`
+	assert.Equal(t, expectedOut, tw.RenderHTML())
+}
+
+func TestTable_RenderHTML_DataBarsAndSparklines(t *testing.T) {
+	tw := NewWriter()
+	tw.AppendHeader(Row{"Host", "Region", "Usage", "Trend"})
+	tw.AppendRows([]Row{
+		{"alpha", "eu", 80, []float64{1, 2, 4, 8}},
+		{"beta", "us", 5, []int{3, 3, 3}},
+		{"gamma", "us", 100, "n/a"},
+	})
+	tw.SetColumnConfigs([]ColumnConfig{
+		{Name: "Region", Hidden: true},
+		{Name: "Usage", DataBar: &DataBar{Width: 5}},
+		{Name: "Trend", Sparkline: &Sparkline{Colors: text.Colors{text.FgRed}}},
+	})
+	tw.SortBy([]SortBy{{Name: "Usage", Mode: DscNumeric}})
+
+	expectedOut := `<table class="go-pretty-table">
+  <thead>
+  <tr>
+    <th>Host</th>
+    <th align="right">Usage</th>
+    <th>Trend</th>
+  </tr>
+  </thead>
+  <tbody>
+  <tr>
+    <td>gamma</td>
+    <td align="right"><svg class="data-bar" width="40" height="12"><rect width="40" height="12" fill="currentColor"/></svg> 100</td>
+    <td>n/a</td>
+  </tr>
+  <tr>
+    <td>alpha</td>
+    <td align="right"><svg class="data-bar" width="40" height="12"><rect width="32" height="12" fill="currentColor"/></svg> 80</td>
+    <td><svg class="sparkline" style="color: #cd0000" width="32" height="12"><polyline fill="none" stroke="currentColor" points="4,11 12,9.57 20,6.71 28,1"/></svg></td>
+  </tr>
+  <tr>
+    <td>beta</td>
+    <td align="right"><svg class="data-bar" width="40" height="12"><rect width="2" height="12" fill="currentColor"/></svg> 5</td>
+    <td><svg class="sparkline" style="color: #cd0000" width="24" height="12"><polyline fill="none" stroke="currentColor" points="4,11 12,11 20,11"/></svg></td>
+  </tr>
+  </tbody>
+</table>`
+	assert.Equal(t, expectedOut, tw.RenderHTML())
+}
+
+func TestTable_RenderHTML_Hyperlinks(t *testing.T) {
+	tw := NewWriter()
+	tw.AppendHeader(Row{"Name", "Home"})
+	tw.AppendRow(Row{"go-pretty", "https://github.com/jedib0t/go-pretty?a=1&b=2"})
+	tw.AppendRow(Row{"Go", "https://go.dev"})
+	tw.SetColumnConfigs([]ColumnConfig{{
+		Name: "Home",
+		Transformer: text.NewHyperlinkTransformer(func(url string) string {
+			return strings.TrimPrefix(url, "https://")
+		}),
+	}})
+	tw.Style().Color = ColorOptions{}
+
+	expectedOut := `<table class="go-pretty-table">
+  <thead>
+  <tr>
+    <th>Name</th>
+    <th>Home</th>
+  </tr>
+  </thead>
+  <tbody>
+  <tr>
+    <td>go-pretty</td>
+    <td><a href="https://github.com/jedib0t/go-pretty?a=1&amp;b=2">` + "\x1b[4;34mgithub.com/jedib0t/go-pretty?a=1&amp;b=2\x1b[0m" + `</a></td>
+  </tr>
+  <tr>
+    <td>Go</td>
+    <td><a href="https://go.dev">` + "\x1b[4;34mgo.dev\x1b[0m" + `</a></td>
+  </tr>
+  </tbody>
+</table>`
+	assert.Equal(t, expectedOut, tw.RenderHTML())
+}
+
+func TestTable_RenderHTML_ExtendedColors(t *testing.T) {
+	tw := NewWriter()
+	tw.AppendHeader(Row{"Item", "Stock"})
+	tw.AppendRow(Row{"Apples", 3})
+	tw.AppendRow(Row{"Oranges", 30})
+	tw.SetColumnConfigs([]ColumnConfig{
+		{Name: "Item", Colors: text.Colors{text.Bold, text.FgRGB(255, 136, 0)}},
+		{Name: "Stock", Colors: text.Colors{text.Bg256(236)}, ColorRules: []ColorRule{
+			ColorRuleLessThan(10, text.Colors{text.FgRed}),
+		}},
+	})
+
+	expectedOut := `<table class="go-pretty-table">
+  <thead>
+  <tr>
+    <th>Item</th>
+    <th align="right">Stock</th>
+  </tr>
+  </thead>
+  <tbody>
+  <tr>
+    <td class="bold" style="color: #ff8800">Apples</td>
+    <td align="right" style="background-color: #303030; color: #cd0000">3</td>
+  </tr>
+  <tr>
+    <td class="bold" style="color: #ff8800">Oranges</td>
+    <td align="right" style="background-color: #303030">30</td>
+  </tr>
+  </tbody>
+</table>`
+	assert.Equal(t, expectedOut, tw.RenderHTML())
+}
+
+func TestTable_RenderHTML_ConvertANSI(t *testing.T) {
+	tw := NewWriter()
+	tw.AppendHeader(Row{"Name", "Status", "Home"})
+	tw.AppendRow(Row{"go-pretty", text.FgGreen.Sprint("PASS") + " <ok>", "https://github.com/jedib0t/go-pretty"})
+	tw.AppendRow(Row{"Go", text.Colors{text.Bold, text.Fg256(208)}.Sprint("WARN\nslow"), "https://go.dev"})
+	tw.SetColumnConfigs([]ColumnConfig{{
+		Name: "Home",
+		Transformer: text.NewHyperlinkTransformer(func(url string) string {
+			return strings.TrimPrefix(url, "https://")
+		}),
+	}})
+	tw.Style().Color = ColorOptions{}
+	tw.Style().HTML.ConvertANSI = true
+
+	expectedOut := `<table class="go-pretty-table">
+  <thead>
+  <tr>
+    <th>Name</th>
+    <th>Status</th>
+    <th>Home</th>
+  </tr>
+  </thead>
+  <tbody>
+  <tr>
+    <td>go-pretty</td>
+    <td><span class="fg-green">PASS</span> &lt;ok&gt;</td>
+    <td><a href="https://github.com/jedib0t/go-pretty"><span class="fg-blue underline">github.com/jedib0t/go-pretty</span></a></td>
+  </tr>
+  <tr>
+    <td>Go</td>
+    <td><span class="bold" style="color: #ff8700">WARN<br/>slow</span></td>
+    <td><a href="https://go.dev"><span class="fg-blue underline">go.dev</span></a></td>
+  </tr>
+  </tbody>
+</table>`
+	assert.Equal(t, expectedOut, tw.RenderHTML())
+
+	tw.Style().HTML.InlineCSS = true
+	out := tw.RenderHTML()
+	assert.Contains(t, out, `<td style="padding: 0 1ch; border-left: 1px solid"><span style="color: #00cd00">PASS</span> &lt;ok&gt;</td>`)
+	assert.Contains(t, out, `<td style="padding: 0 1ch; border-left: 1px solid"><span style="font-weight: bold; color: #ff8700">WARN<br/>slow</span></td>`)
+}
+
+func Test_htmlSparkline(t *testing.T) {
+	sparkline := &Sparkline{}
+
+	assert.Equal(t, `<svg class="sparkline" width="32" height="12">`+
+		`<polyline fill="none" stroke="currentColor" points="4,11 12,1"/>`+
+		`<polyline fill="none" stroke="currentColor" points="28,6"/></svg>`,
+		htmlSparkline([]float64{1, 3, math.NaN(), 2}, sparkline))
+	assert.Equal(t, `<svg class="sparkline" width="24" height="12">`+
+		`<polyline fill="none" stroke="currentColor" points="4,11"/>`+
+		`<polyline fill="none" stroke="currentColor" points="20,1"/></svg>`,
+		htmlSparkline([]float64{1, math.Inf(1), 2}, sparkline))
+	assert.Equal(t, `<svg class="sparkline" width="16" height="12"></svg>`,
+		htmlSparkline([]float64{math.NaN(), math.Inf(-1)}, sparkline))
+}
+
+func TestTable_RenderHTML_HyperlinksUnsafe(t *testing.T) {
+	tw := NewWriter()
+	tw.AppendRow(Row{text.Hyperlink("javascript:alert(1)", "click me"), text.Hyperlink("mailto:ghost@lady.com", "mail me")})
+	tw.Style().HTML.EscapeText = false
+
+	expectedOut := `<table class="go-pretty-table">
+  <tbody>
+  <tr>
+    <td>click me</td>
+    <td><a href="mailto:ghost@lady.com">mail me</a></td>
+  </tr>
+  </tbody>
+</table>`
+	assert.Equal(t, expectedOut, tw.RenderHTML())
+}
+
+func Test_htmlDataBar(t *testing.T) {
+	dataBar := &DataBar{Width: 2}
+
+	assert.Equal(t, `<svg class="data-bar" width="16" height="12"><rect width="8" height="12" fill="currentColor"/></svg>`,
+		htmlDataBar(0.5, dataBar))
+	for _, fraction := range []float64{math.NaN(), math.Inf(-1), -1} {
+		assert.Equal(t, `<svg class="data-bar" width="16" height="12"><rect width="0" height="12" fill="currentColor"/></svg>`,
+			htmlDataBar(fraction, dataBar))
+	}
+	assert.Equal(t, `<svg class="data-bar" width="16" height="12"><rect width="16" height="12" fill="currentColor"/></svg>`,
+		htmlDataBar(math.Inf(1), dataBar))
+}
+
+func TestTable_RenderHTML_DataBarsWithNonFiniteValues(t *testing.T) {
+	tw := NewWriter()
+	tw.AppendRows([]Row{{1.0}, {math.NaN()}, {math.Inf(1)}, {4.0}})
+	tw.SetColumnConfigs([]ColumnConfig{{Number: 1, DataBar: &DataBar{Width: 4, HideValue: true}}})
+
+	out := tw.RenderHTML()
+	assert.NotContains(t, out, "NaN")
+	assert.Contains(t, out, `<rect width="8" height="12"`)
+	assert.Contains(t, out, `<rect width="32" height="12"`)
+	assert.Equal(t, 2, strings.Count(out, `<rect width="0" height="12"`))
+}
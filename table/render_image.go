@@ -0,0 +1,56 @@
+package table
+
+import (
+	"image/png"
+	"io"
+	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/text"
+)
+
+// RenderSVG renders the Table (as rendered by Render, with all its colors) as
+// an SVG image of a terminal window with a monospace font, using the options
+// in Style().Image (see text.ANSIToSVG). This makes it possible to embed the
+// Table in places that cannot display text with colors, like READMEs and
+// slides. For ex.:
+//  <svg xmlns="http://www.w3.org/2000/svg" width="596.4" height="151.2" viewBox="0 0 596.4 151.2">
+//  <rect width="100%" height="100%" fill="#000000"/>
+//  <g font-family="monospace" font-size="14" xml:space="preserve">
+//  <text x="0" y="14" fill="#e5e5e5" textLength="596.4" lengthAdjust="spacingAndGlyphs">+-----+------------+-----------+--------+-----------------------------+</text>
+//  ...
+//  </g>
+//  </svg>
+//
+// The colors are rendered even if they are disabled globally (as the output is
+// not meant for a terminal), unless the Table has its own ColorProfile set
+// using SetColorProfile.
+func (t *Table) RenderSVG() string {
+	var out strings.Builder
+	out.WriteString(text.ANSIToSVG(t.imageRenderText(), t.Style().Image))
+	return t.render(&out)
+}
+
+// RenderPNG renders the Table (as rendered by Render, with all its colors) as
+// a PNG image of a terminal window into the given io.Writer, using the options
+// in Style().Image and the bitmap font bundled with the text package (see
+// text.ANSIToImage). The colors are rendered the same way as RenderSVG. The
+// PNG is not written to the output mirror (if any).
+func (t *Table) RenderPNG(w io.Writer) error {
+	return png.Encode(w, text.ANSIToImage(t.imageRenderText(), t.Style().Image))
+}
+
+// imageRenderText renders the Table in the text format with all the colors
+// for converting into an image.
+func (t *Table) imageRenderText() string {
+	if t.colorProfile == nil {
+		trueColor := text.ColorProfileTrueColor
+		t.colorProfile = &trueColor
+		defer func() {
+			t.colorProfile = nil
+		}()
+	}
+
+	var out strings.Builder
+	t.renderText(&out)
+	return t.getColorProfile().Convert(out.String())
+}
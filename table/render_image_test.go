@@ -0,0 +1,76 @@
+package table
+
+import (
+	"bytes"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTable_RenderSVG(t *testing.T) {
+	tw := NewWriter()
+	tw.AppendHeader(Row{"#", "Name"})
+	tw.AppendRow(Row{1, "Arya"})
+	tw.SetColumnConfigs([]ColumnConfig{{Name: "Name", Colors: text.Colors{text.FgRed}}})
+	tw.Style().Image.FontSize = 10
+
+	expectedOut := `<svg xmlns="http://www.w3.org/2000/svg" width="72" height="60" viewBox="0 0 72 60">
+<rect width="100%" height="100%" fill="#000000"/>
+<g font-family="monospace" font-size="10" xml:space="preserve">
+<text x="0" y="10" fill="#e5e5e5" textLength="72" lengthAdjust="spacingAndGlyphs">+---+------+</text>
+<text x="0" y="22" fill="#e5e5e5" textLength="72" lengthAdjust="spacingAndGlyphs">| # | NAME |</text>
+<text x="0" y="34" fill="#e5e5e5" textLength="72" lengthAdjust="spacingAndGlyphs">+---+------+</text>
+<text x="0" y="46" fill="#e5e5e5" textLength="30" lengthAdjust="spacingAndGlyphs">| 1 |</text>
+<text x="30" y="46" fill="#cd0000" textLength="36" lengthAdjust="spacingAndGlyphs"> Arya </text>
+<text x="66" y="46" fill="#e5e5e5" textLength="6" lengthAdjust="spacingAndGlyphs">|</text>
+<text x="0" y="58" fill="#e5e5e5" textLength="72" lengthAdjust="spacingAndGlyphs">+---+------+</text>
+</g>
+</svg>`
+
+	// colors get rendered even if disabled globally
+	text.DisableColors()
+	defer text.EnableColors()
+	assert.Equal(t, expectedOut, tw.RenderSVG())
+	assert.Equal(t, "+---+------+\n| # | NAME |\n+---+------+\n| 1 | Arya |\n+---+------+", tw.Render())
+
+	// unless disabled for the Table
+	tw.SetColorProfile(text.ColorProfileNone)
+	assert.NotContains(t, tw.RenderSVG(), "#cd0000")
+}
+
+func TestTable_RenderSVG_Mirror(t *testing.T) {
+	tw := NewWriter()
+	tw.AppendRow(Row{1, "Arya"})
+	mirror := strings.Builder{}
+	tw.SetOutputMirror(&mirror)
+
+	svg := tw.RenderSVG()
+	assert.True(t, strings.HasPrefix(svg, "<svg "))
+	assert.Equal(t, svg+"\n", mirror.String())
+}
+
+func TestTable_RenderPNG(t *testing.T) {
+	tw := NewWriter()
+	tw.AppendHeader(Row{"#", "Name"})
+	tw.AppendRow(Row{1, "Arya"})
+	tw.SetStyle(StyleLight)
+	tw.Style().Image.Padding = 1
+	tw.Style().Image.Scale = 1
+	mirror := strings.Builder{}
+	tw.SetOutputMirror(&mirror)
+
+	var out bytes.Buffer
+	assert.Nil(t, tw.RenderPNG(&out))
+	assert.Empty(t, mirror.String())
+
+	img, err := png.Decode(&out)
+	assert.Nil(t, err)
+	if img != nil {
+		// 12x5 characters with a padding of 1 character, at 6x10 px each
+		assert.Equal(t, 14*6, img.Bounds().Dx())
+		assert.Equal(t, 7*10, img.Bounds().Dy())
+	}
+}
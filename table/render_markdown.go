@@ -3,6 +3,17 @@ package table
 import (
 	"fmt"
 	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/text"
+)
+
+var (
+	// markdownEscaper escapes the characters that would otherwise be
+	// interpreted as Markdown/HTML
+	markdownEscaper = strings.NewReplacer("\\", "\\\\", "`", "\\`", "|", "\\|", "&", "&amp;", "<", "&lt;", ">", "&gt;")
+	// markdownPipeEscaper escapes just the pipes that would otherwise end the
+	// cells
+	markdownPipeEscaper = strings.NewReplacer("|", "\\|")
 )
 
 // RenderMarkdown renders the Table in Markdown format. Example:
@@ -12,11 +23,21 @@ import (
 //  | 20 | Jon | Snow | 2000 | You know nothing, Jon Snow! |
 //  | 300 | Tyrion | Lannister | 5000 |  |
 //  |  |  | Total | 10000 |  |
+//
+// The rendering can be customized using Style().Markdown; for ex., with
+// MarkdownOptions.BoldFooter and MarkdownOptions.PadColumns enabled:
+//  |   # | First Name | Last Name |    Salary |                             |
+//  | ---:| ---------- | --------- | ---------:| --------------------------- |
+//  |   1 | Arya       | Stark     |      3000 |                             |
+//  |  20 | Jon        | Snow      |      2000 | You know nothing, Jon Snow! |
+//  | 300 | Tyrion     | Lannister |      5000 |                             |
+//  |     |            | **Total** | **10000** |                             |
 func (t *Table) RenderMarkdown() string {
 	t.initForRender()
 
 	var out strings.Builder
 	if t.numColumns > 0 {
+		t.markdownInitColumnWidths()
 		t.markdownRenderTitle(&out)
 		t.markdownRenderRowsHeader(&out)
 		t.markdownRenderRows(&out, t.rows, renderHint{})
@@ -26,11 +47,101 @@ func (t *Table) RenderMarkdown() string {
 	return t.render(&out)
 }
 
+// markdownEscape returns the text escaped as per the options, with the
+// new-lines replaced so that the text fits in a single line.
+func (t *Table) markdownEscape(str string) string {
+	if t.style.Markdown.EscapeText {
+		str = markdownEscaper.Replace(str)
+	} else {
+		str = markdownPipeEscaper.Replace(str)
+	}
+	newline := t.style.Markdown.Newline
+	if newline == "" { // Style literals without MarkdownOptions
+		newline = "<br/>"
+	}
+	if newline != "\n" {
+		str = strings.Replace(str, "\n", newline, -1)
+	}
+	return str
+}
+
+// markdownGetAlign returns the alignment for the marker in the separator row
+// for the given column.
+func (t *Table) markdownGetAlign(colIdx int) text.Align {
+	if cfg, ok := t.columnConfigMap[colIdx]; ok && t.style.Markdown.AlignFromHeader {
+		if cfg.AlignHeader != text.AlignDefault {
+			return cfg.AlignHeader
+		}
+	}
+	return t.getAlign(colIdx, renderHint{isSeparatorRow: true})
+}
+
+// markdownGetCells returns the text for all the cells in the row, including
+// the auto-index column (if enabled).
+func (t *Table) markdownGetCells(row rowStr, hint renderHint) []string {
+	var cells []string
+	if t.autoIndex {
+		var cell string
+		if hint.isRegularRow() {
+			cell = fmt.Sprint(hint.rowNumber)
+		}
+		cells = append(cells, cell)
+	}
+	for colIdx := 0; colIdx < t.numColumns; colIdx++ {
+		var colStr string
+		if colIdx < len(row) {
+			colStr = t.markdownEscape(row[colIdx])
+		}
+		if colStr != "" && hint.isFooterRow && t.style.Markdown.BoldFooter {
+			colStr = "**" + colStr + "**"
+		}
+		cells = append(cells, colStr)
+	}
+	return cells
+}
+
+// markdownInitColumnWidths determines the width of every column when the
+// columns need to be padded.
+func (t *Table) markdownInitColumnWidths() {
+	t.markdownColumnWidths = nil
+	if !t.style.Markdown.PadColumns {
+		return
+	}
+
+	widths := make([]int, t.numColumns)
+	if t.autoIndex {
+		widths = append(widths, 0)
+	}
+	updateWidths := func(rows []rowStr, hint renderHint) {
+		for idx, row := range rows {
+			hint.rowNumber = idx + 1
+			for cellIdx, cell := range t.markdownGetCells(row, hint) {
+				if width := text.RuneCount(cell); width > widths[cellIdx] {
+					widths[cellIdx] = width
+				}
+			}
+		}
+	}
+	if len(t.rowsHeader) > 0 {
+		updateWidths(t.rowsHeader, renderHint{isHeaderRow: true})
+	} else if t.autoIndex {
+		updateWidths([]rowStr{t.getAutoIndexColumnIDs()}, renderHint{isAutoIndexRow: true, isHeaderRow: true})
+	}
+	updateWidths(t.rows, renderHint{})
+	updateWidths(t.rowsFooter, renderHint{isFooterRow: true})
+	for idx := range widths {
+		if widths[idx] < 3 {
+			widths[idx] = 3
+		}
+	}
+	t.markdownColumnWidths = widths
+}
+
 func (t *Table) markdownRenderCaption(out *strings.Builder) {
 	if t.caption != "" {
 		out.WriteRune('\n')
 		out.WriteRune('_')
-		out.WriteString(t.caption)
+		out.WriteString(t.markdownEscape(t.caption))
 		out.WriteRune('_')
 	}
 }
@@ -43,33 +154,33 @@ func (t *Table) markdownRenderRow(out *strings.Builder, row rowStr, hint renderH
 
 	// render each column up to the max. columns seen in all the rows
 	out.WriteRune('|')
-	for colIdx := 0; colIdx < t.numColumns; colIdx++ {
-		// auto-index column
-		if colIdx == 0 && t.autoIndex {
-			out.WriteRune(' ')
-			if hint.isSeparatorRow {
-				out.WriteString("---:")
-			} else if hint.isRegularRow() {
-				out.WriteString(fmt.Sprintf("%d ", hint.rowNumber))
-			}
-			out.WriteRune('|')
+	for cellIdx, cell := range t.markdownGetCells(row, hint) {
+		colIdx, align := cellIdx, text.AlignRight
+		if t.autoIndex {
+			colIdx--
+		}
+		width := 3
+		if t.markdownColumnWidths != nil {
+			width = t.markdownColumnWidths[cellIdx]
 		}
 
 		if hint.isSeparatorRow {
-			out.WriteString(t.getAlign(colIdx, hint).MarkdownProperty())
-		} else {
-			var colStr string
-			if colIdx < len(row) {
-				colStr = row[colIdx]
+			if colIdx >= 0 {
+				align = t.markdownGetAlign(colIdx)
 			}
+			out.WriteString(markdownSeparator(align, width))
+		} else if colIdx < 0 && cell == "" && t.markdownColumnWidths == nil {
+			// auto-index column in the header/footer rows
 			out.WriteRune(' ')
-			if strings.Contains(colStr, "|") {
-				colStr = strings.Replace(colStr, "|", "\\|", -1)
+		} else {
+			if colIdx >= 0 {
+				align = t.getAlign(colIdx, hint)
 			}
-			if strings.Contains(colStr, "\n") {
-				colStr = strings.Replace(colStr, "\n", "<br/>", -1)
+			if t.markdownColumnWidths != nil {
+				cell = align.Apply(cell, width)
 			}
-			out.WriteString(colStr)
+			out.WriteRune(' ')
+			out.WriteString(cell)
 			out.WriteRune(' ')
 		}
 		out.WriteRune('|')
@@ -105,6 +216,22 @@ func (t *Table) markdownRenderRowsHeader(out *strings.Builder) {
 func (t *Table) markdownRenderTitle(out *strings.Builder) {
 	if t.title != "" {
 		out.WriteString("# ")
-		out.WriteString(t.title)
+		out.WriteString(t.markdownEscape(t.title))
+	}
+}
+
+// markdownSeparator returns the marker for a column in the separator row
+// (with the dashes as wide as the column) denoting the alignment.
+func markdownSeparator(align text.Align, width int) string {
+	dashes := strings.Repeat("-", width)
+	switch align {
+	case text.AlignLeft:
+		return ":" + dashes + " "
+	case text.AlignCenter:
+		return ":" + dashes + ":"
+	case text.AlignRight:
+		return " " + dashes + ":"
+	default:
+		return " " + dashes + " "
 	}
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/jedib0t/go-pretty/v6/text"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -143,3 +144,106 @@ func TestTable_RendeMarkdown_Sorted(t *testing.T) {
 |  |  | Total | 10000 |  |`
 	assert.Equal(t, expectedOut, tw.RenderMarkdown())
 }
+
+func TestTable_RenderMarkdown_Options(t *testing.T) {
+	tw := NewWriter()
+	tw.AppendHeader(testHeader)
+	tw.AppendRows(testRows)
+	tw.AppendFooter(testFooter)
+	tw.Style().Markdown.BoldFooter = true
+	tw.Style().Markdown.PadColumns = true
+
+	expectedOut := `|   # | First Name | Last Name |    Salary |                             |
+| ---:| ---------- | --------- | ---------:| --------------------------- |
+|   1 | Arya       | Stark     |      3000 |                             |
+|  20 | Jon        | Snow      |      2000 | You know nothing, Jon Snow! |
+| 300 | Tyrion     | Lannister |      5000 |                             |
+|     |            | **Total** | **10000** |                             |`
+	assert.Equal(t, expectedOut, tw.RenderMarkdown())
+
+	t.Run("align from header", func(t *testing.T) {
+		tw.SetColumnConfigs([]ColumnConfig{
+			{Number: 2, AlignHeader: text.AlignCenter},
+			{Number: 3, Align: text.AlignRight, AlignHeader: text.AlignLeft},
+		})
+		tw.Style().Markdown.AlignFromHeader = true
+
+		expectedOut := `|   # | First Name | Last Name |    Salary |                             |
+| ---:|:----------:|:--------- | ---------:| --------------------------- |
+|   1 | Arya       |     Stark |      3000 |                             |
+|  20 | Jon        |      Snow |      2000 | You know nothing, Jon Snow! |
+| 300 | Tyrion     | Lannister |      5000 |                             |
+|     |            | **Total** | **10000** |                             |`
+		assert.Equal(t, expectedOut, tw.RenderMarkdown())
+	})
+}
+
+func TestTable_RenderMarkdown_CustomStyle(t *testing.T) {
+	tw := NewWriter()
+	tw.AppendHeader(Row{"Name", "Notes"})
+	tw.AppendRow(Row{"Arya", "line1\nline2"})
+	tw.SetStyle(Style{Name: "StyleCustom", Box: StyleBoxLight, Options: OptionsDefault})
+
+	// Style literals without MarkdownOptions render new-lines as before
+	expectedOut := `| Name | Notes |
+| --- | --- |
+| Arya | line1<br/>line2 |`
+	assert.Equal(t, expectedOut, tw.RenderMarkdown())
+}
+
+func TestTable_RenderMarkdown_Escaping(t *testing.T) {
+	tw := NewWriter()
+	tw.AppendHeader(Row{"Code", "HTML"})
+	tw.AppendRow(Row{"`a|b` \\ c", "<b>Jon & Arya</b>\nStark"})
+	tw.SetTitle("<Game> of `Thrones`")
+	tw.SetCaption("A Song of Ice & Fire")
+	tw.SetAutoIndex(true)
+	tw.Style().Markdown.Newline = "<br>"
+
+	expectedOut := "# <Game> of `Thrones`\n" +
+		"| | Code | HTML |\n" +
+		"| ---:| --- | --- |\n" +
+		"| 1 | `a\\|b` \\ c | <b>Jon & Arya</b><br>Stark |\n" +
+		"_A Song of Ice & Fire_"
+	assert.Equal(t, expectedOut, tw.RenderMarkdown())
+
+	tw.Style().Markdown.EscapeText = true
+	expectedOut = "# &lt;Game&gt; of \\`Thrones\\`\n" +
+		"| | Code | HTML |\n" +
+		"| ---:| --- | --- |\n" +
+		"| 1 | \\`a\\|b\\` \\\\ c | &lt;b&gt;Jon &amp; Arya&lt;/b&gt;<br>Stark |\n" +
+		"_A Song of Ice &amp; Fire_"
+	assert.Equal(t, expectedOut, tw.RenderMarkdown())
+
+	t.Run("padded", func(t *testing.T) {
+		tw.Style().Markdown.PadColumns = true
+
+		expectedOut := "# &lt;Game&gt; of \\`Thrones\\`\n" +
+			"|     | Code          | HTML                                       |\n" +
+			"| ---:| ------------- | ------------------------------------------ |\n" +
+			"|   1 | \\`a\\|b\\` \\\\ c | &lt;b&gt;Jon &amp; Arya&lt;/b&gt;<br>Stark |\n" +
+			"_A Song of Ice &amp; Fire_"
+		assert.Equal(t, expectedOut, tw.RenderMarkdown())
+	})
+}
+
+func TestTable_RenderMarkdown_DataBarsAndSparklines(t *testing.T) {
+	tw := NewWriter()
+	tw.AppendHeader(Row{"Host", "Usage", "Trend"})
+	tw.AppendRows([]Row{
+		{"alpha", 80, []float64{1, 2, 4, 8}},
+		{"beta", 5, []int{3, 3, 3}},
+	})
+	tw.SetColumnConfigs([]ColumnConfig{
+		{Name: "Usage", DataBar: &DataBar{Width: 5}},
+		{Name: "Trend", Sparkline: &Sparkline{}},
+	})
+	assert.Contains(t, tw.RenderHTML(), "<svg class=\"sparkline\"")
+
+	// the data bars and sparklines are not rendered in place of the values
+	expectedOut := `| Host | Usage | Trend |
+| --- | ---:| --- |
+| alpha | 80 | [1 2 4 8] |
+| beta | 5 | [3 3 3] |`
+	assert.Equal(t, expectedOut, tw.RenderMarkdown())
+}
@@ -0,0 +1,186 @@
+package table
+
+import (
+	"io"
+	"strconv"
+	"strings"
+)
+
+const (
+	odsMimeType  = "application/vnd.oasis.opendocument.spreadsheet"
+	odsXMLHeader = `<?xml version="1.0" encoding="UTF-8"?>` + "\n"
+
+	odsManifest = odsXMLHeader + `<manifest:manifest xmlns:manifest="urn:oasis:names:tc:opendocument:xmlns:manifest:1.0" manifest:version="1.2">
+<manifest:file-entry manifest:full-path="/" manifest:version="1.2" manifest:media-type="` + odsMimeType + `"/>
+<manifest:file-entry manifest:full-path="content.xml" manifest:media-type="text/xml"/>
+<manifest:file-entry manifest:full-path="settings.xml" manifest:media-type="text/xml"/>
+</manifest:manifest>`
+
+	// odsCellStyles defines the cell styles referred to by the cells; see
+	// odsCellStyle for the names
+	odsCellStyles = `<number:date-style style:name="N1"><number:year number:style="long"/><number:text>-</number:text>` +
+		`<number:month number:style="long"/><number:text>-</number:text><number:day number:style="long"/></number:date-style>
+<number:date-style style:name="N2"><number:year number:style="long"/><number:text>-</number:text>` +
+		`<number:month number:style="long"/><number:text>-</number:text><number:day number:style="long"/>` +
+		`<number:text> </number:text><number:hours number:style="long"/><number:text>:</number:text>` +
+		`<number:minutes number:style="long"/><number:text>:</number:text><number:seconds number:style="long"/></number:date-style>
+<style:style style:name="ce1" style:family="table-cell"><style:text-properties fo:font-weight="bold"/></style:style>
+<style:style style:name="ce2" style:family="table-cell" style:data-style-name="N1"/>
+<style:style style:name="ce3" style:family="table-cell" style:data-style-name="N1"><style:text-properties fo:font-weight="bold"/></style:style>
+<style:style style:name="ce4" style:family="table-cell" style:data-style-name="N2"/>
+<style:style style:name="ce5" style:family="table-cell" style:data-style-name="N2"><style:text-properties fo:font-weight="bold"/></style:style>
+`
+
+	// odsColumnWidthPerChar is the width (in inches) of every character in a
+	// column
+	odsColumnWidthPerChar = 0.08
+)
+
+// RenderODS renders the Table as an OpenDocument spreadsheet (used by
+// LibreOffice, etc.) with a single sheet into the given io.Writer. The contents
+// are laid out exactly like RenderXLSX does. The spreadsheet is not written to
+// the output mirror (if any).
+func (t *Table) RenderODS(w io.Writer) error {
+	sheet := t.spreadsheetGenerate()
+
+	return spreadsheetWriteZip(w, [][2]string{
+		{"mimetype", odsMimeType},
+		{"META-INF/manifest.xml", odsManifest},
+		{"content.xml", odsContent(sheet)},
+		{"settings.xml", odsSettings(sheet)},
+	}, "mimetype")
+}
+
+// odsCellStyle returns the name of the cell style (in odsCellStyles) to use
+// for the cell.
+func odsCellStyle(cell spreadsheetCell) string {
+	style := 0
+	switch cell.kind {
+	case spreadsheetCellDate:
+		style = 2
+	case spreadsheetCellDateTime:
+		style = 4
+	}
+	if cell.bold {
+		style++
+	}
+	if style == 0 {
+		return ""
+	}
+	return "ce" + strconv.Itoa(style)
+}
+
+// odsContent returns the XML for the document with the contents of the
+// spreadsheet.
+func odsContent(sheet spreadsheet) string {
+	var out strings.Builder
+	out.WriteString(odsXMLHeader)
+	out.WriteString(`<office:document-content xmlns:office="urn:oasis:names:tc:opendocument:xmlns:office:1.0"`)
+	out.WriteString(` xmlns:style="urn:oasis:names:tc:opendocument:xmlns:style:1.0"`)
+	out.WriteString(` xmlns:text="urn:oasis:names:tc:opendocument:xmlns:text:1.0"`)
+	out.WriteString(` xmlns:table="urn:oasis:names:tc:opendocument:xmlns:table:1.0"`)
+	out.WriteString(` xmlns:fo="urn:oasis:names:tc:opendocument:xmlns:xsl-fo-compatible:1.0"`)
+	out.WriteString(` xmlns:number="urn:oasis:names:tc:opendocument:xmlns:datastyle:1.0" office:version="1.2">` + "\n")
+	out.WriteString("<office:automatic-styles>\n")
+	for colIdx, width := range sheet.columnWidths {
+		out.WriteString(`<style:style style:name="co` + strconv.Itoa(colIdx+1) + `" style:family="table-column">`)
+		out.WriteString(`<style:table-column-properties style:column-width="`)
+		out.WriteString(strconv.FormatFloat(float64(width+2)*odsColumnWidthPerChar, 'f', 2, 64) + `in"/></style:style>` + "\n")
+	}
+	out.WriteString(odsCellStyles)
+	out.WriteString("</office:automatic-styles>\n")
+	out.WriteString("<office:body>\n<office:spreadsheet>\n")
+	out.WriteString(`<table:table table:name="` + xmlEscape(sheet.name) + `">` + "\n")
+	for colIdx := range sheet.columnWidths {
+		out.WriteString(`<table:table-column table:style-name="co` + strconv.Itoa(colIdx+1) + `"/>` + "\n")
+	}
+	for rowIdx, row := range sheet.rows {
+		if rowIdx == 0 && sheet.numHeaderRows > 0 {
+			out.WriteString("<table:table-header-rows>\n")
+		}
+		out.WriteString("<table:table-row>")
+		for _, cell := range row {
+			odsWriteCell(&out, cell)
+		}
+		out.WriteString("</table:table-row>\n")
+		if rowIdx == sheet.numHeaderRows-1 {
+			out.WriteString("</table:table-header-rows>\n")
+		}
+	}
+	out.WriteString("</table:table>\n</office:spreadsheet>\n</office:body>\n</office:document-content>")
+	return out.String()
+}
+
+// odsSettings returns the XML for the settings to freeze the header rows in
+// place.
+func odsSettings(sheet spreadsheet) string {
+	var out strings.Builder
+	out.WriteString(odsXMLHeader)
+	out.WriteString(`<office:document-settings xmlns:office="urn:oasis:names:tc:opendocument:xmlns:office:1.0"`)
+	out.WriteString(` xmlns:config="urn:oasis:names:tc:opendocument:xmlns:config:1.0" office:version="1.2">` + "\n")
+	out.WriteString("<office:settings>\n")
+	if sheet.numHeaderRows > 0 && sheet.numHeaderRows < len(sheet.rows) {
+		numHeaderRows := strconv.Itoa(sheet.numHeaderRows)
+		configItem := func(name, configType, value string) {
+			out.WriteString(`<config:config-item config:name="` + name + `" config:type="` + configType + `">` + value + "</config:config-item>\n")
+		}
+		out.WriteString(`<config:config-item-set config:name="ooo:view-settings">` + "\n")
+		out.WriteString(`<config:config-item-map-indexed config:name="Views">` + "\n")
+		out.WriteString("<config:config-item-map-entry>\n")
+		configItem("ViewId", "string", "view1")
+		out.WriteString(`<config:config-item-map-named config:name="Tables">` + "\n")
+		out.WriteString(`<config:config-item-map-entry config:name="` + xmlEscape(sheet.name) + `">` + "\n")
+		configItem("VerticalSplitMode", "short", "2")
+		configItem("VerticalSplitPosition", "int", numHeaderRows)
+		configItem("ActiveSplitRange", "short", "2")
+		configItem("PositionTop", "int", "0")
+		configItem("PositionBottom", "int", numHeaderRows)
+		out.WriteString("</config:config-item-map-entry>\n")
+		out.WriteString("</config:config-item-map-named>\n")
+		out.WriteString("</config:config-item-map-entry>\n")
+		out.WriteString("</config:config-item-map-indexed>\n")
+		out.WriteString("</config:config-item-set>\n")
+	}
+	out.WriteString("</office:settings>\n</office:document-settings>")
+	return out.String()
+}
+
+// odsWriteCell writes the XML for the cell.
+func odsWriteCell(out *strings.Builder, cell spreadsheetCell) {
+	if cell.covered {
+		out.WriteString("<table:covered-table-cell/>")
+		return
+	}
+
+	out.WriteString("<table:table-cell")
+	if style := odsCellStyle(cell); style != "" {
+		out.WriteString(` table:style-name="` + style + `"`)
+	}
+	if cell.mergeCols > 1 {
+		out.WriteString(` table:number-columns-spanned="` + strconv.Itoa(cell.mergeCols) + `"`)
+	}
+	if cell.mergeRows > 1 {
+		out.WriteString(` table:number-rows-spanned="` + strconv.Itoa(cell.mergeRows) + `"`)
+	}
+	switch cell.kind {
+	case spreadsheetCellBool:
+		out.WriteString(` office:value-type="boolean" office:boolean-value="` + cell.value + `"`)
+	case spreadsheetCellDate:
+		out.WriteString(` office:value-type="date" office:date-value="` + cell.time.Format("2006-01-02") + `"`)
+	case spreadsheetCellDateTime:
+		out.WriteString(` office:value-type="date" office:date-value="` + cell.time.Format("2006-01-02T15:04:05.999999999") + `"`)
+	case spreadsheetCellNumber:
+		out.WriteString(` office:value-type="float" office:value="` + cell.value + `"`)
+	default:
+		if cell.value == "" {
+			out.WriteString("/>")
+			return
+		}
+		out.WriteString(` office:value-type="string"`)
+	}
+	out.WriteString(">")
+	for _, line := range strings.Split(cell.value, "\n") {
+		out.WriteString("<text:p>" + xmlEscape(line) + "</text:p>")
+	}
+	out.WriteString("</table:table-cell>")
+}
@@ -0,0 +1,89 @@
+package table
+
+import (
+	"archive/zip"
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTable_RenderODS(t *testing.T) {
+	tw := NewWriter()
+	tw.AppendHeader(Row{"#", "Name", "Name", "Born", "Alive"}, RowConfig{AutoMerge: true})
+	tw.AppendRow(Row{1, "Arya", "Stark", time.Date(2000, time.March, 1, 0, 0, 0, 0, time.UTC), true})
+	tw.AppendRow(Row{20, "Jon", "Snow\n<Targaryen>", time.Date(2000, time.March, 1, 12, 0, 0, 0, time.UTC), false})
+	tw.AppendFooter(Row{"", "", "Total", 21.5})
+	tw.SetTitle("Game of Thrones")
+	mirror := strings.Builder{}
+	tw.SetOutputMirror(&mirror)
+
+	var out bytes.Buffer
+	assert.Nil(t, tw.RenderODS(&out))
+	assert.Empty(t, mirror.String())
+
+	names, contents := readZip(t, out.Bytes())
+	assert.Equal(t, []string{"mimetype", "META-INF/manifest.xml", "content.xml", "settings.xml"}, names)
+	assert.Equal(t, odsMimeType, contents["mimetype"])
+
+	// the mimetype has to be the first file, and stored without compression
+	zipReader, err := zip.NewReader(bytes.NewReader(out.Bytes()), int64(out.Len()))
+	if assert.Nil(t, err) {
+		assert.Equal(t, zip.Store, zipReader.File[0].Method)
+	}
+
+	content := contents["content.xml"]
+	assert.Contains(t, content, `<style:style style:name="co4" style:family="table-column">`+
+		`<style:table-column-properties style:column-width="2.48in"/></style:style>`)
+	assert.Contains(t, content, `<table:table table:name="Game of Thrones">`)
+	assert.Contains(t, content, strings.Join([]string{
+		`<table:table-header-rows>`,
+		`<table:table-row>` +
+			`<table:table-cell table:style-name="ce1" office:value-type="string"><text:p>#</text:p></table:table-cell>` +
+			`<table:table-cell table:style-name="ce1" table:number-columns-spanned="2" office:value-type="string"><text:p>Name</text:p></table:table-cell>` +
+			`<table:covered-table-cell/>` +
+			`<table:table-cell table:style-name="ce1" office:value-type="string"><text:p>Born</text:p></table:table-cell>` +
+			`<table:table-cell table:style-name="ce1" office:value-type="string"><text:p>Alive</text:p></table:table-cell>` +
+			`</table:table-row>`,
+		`</table:table-header-rows>`,
+		`<table:table-row>` +
+			`<table:table-cell office:value-type="float" office:value="1"><text:p>1</text:p></table:table-cell>` +
+			`<table:table-cell office:value-type="string"><text:p>Arya</text:p></table:table-cell>` +
+			`<table:table-cell office:value-type="string"><text:p>Stark</text:p></table:table-cell>` +
+			`<table:table-cell table:style-name="ce2" office:value-type="date" office:date-value="2000-03-01"><text:p>2000-03-01 00:00:00 +0000 UTC</text:p></table:table-cell>` +
+			`<table:table-cell office:value-type="boolean" office:boolean-value="true"><text:p>true</text:p></table:table-cell>` +
+			`</table:table-row>`,
+		`<table:table-row>` +
+			`<table:table-cell office:value-type="float" office:value="20"><text:p>20</text:p></table:table-cell>` +
+			`<table:table-cell office:value-type="string"><text:p>Jon</text:p></table:table-cell>` +
+			`<table:table-cell office:value-type="string"><text:p>Snow</text:p><text:p>&lt;Targaryen&gt;</text:p></table:table-cell>` +
+			`<table:table-cell table:style-name="ce4" office:value-type="date" office:date-value="2000-03-01T12:00:00"><text:p>2000-03-01 12:00:00 +0000 UTC</text:p></table:table-cell>` +
+			`<table:table-cell office:value-type="boolean" office:boolean-value="false"><text:p>false</text:p></table:table-cell>` +
+			`</table:table-row>`,
+		`<table:table-row>` +
+			`<table:table-cell table:style-name="ce1"/>` +
+			`<table:table-cell table:style-name="ce1"/>` +
+			`<table:table-cell table:style-name="ce1" office:value-type="string"><text:p>Total</text:p></table:table-cell>` +
+			`<table:table-cell table:style-name="ce1" office:value-type="float" office:value="21.5"><text:p>21.5</text:p></table:table-cell>` +
+			`<table:table-cell table:style-name="ce1"/>` +
+			`</table:table-row>`,
+		`</table:table>`,
+	}, "\n"))
+
+	settings := contents["settings.xml"]
+	assert.Contains(t, settings, `<config:config-item-map-entry config:name="Game of Thrones">`)
+	assert.Contains(t, settings, `<config:config-item config:name="VerticalSplitPosition" config:type="int">1</config:config-item>`)
+}
+
+func TestTable_RenderODS_Empty(t *testing.T) {
+	tw := NewWriter()
+
+	var out bytes.Buffer
+	assert.Nil(t, tw.RenderODS(&out))
+
+	_, contents := readZip(t, out.Bytes())
+	assert.Contains(t, contents["content.xml"], "<table:table table:name=\"Sheet1\">\n</table:table>")
+	assert.NotContains(t, contents["settings.xml"], "VerticalSplitPosition")
+}
@@ -0,0 +1,262 @@
+package table
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/jedib0t/go-pretty/v6/text"
+)
+
+const (
+	// spreadsheetNameDefault is the name of the sheet for Tables without a
+	// title
+	spreadsheetNameDefault = "Sheet1"
+	// spreadsheetNameMaxLength is the max. length of a sheet name allowed by
+	// spreadsheet applications
+	spreadsheetNameMaxLength = 31
+)
+
+// spreadsheetCellKind is the type of the value in a spreadsheet cell.
+type spreadsheetCellKind int
+
+const (
+	spreadsheetCellString spreadsheetCellKind = iota
+	spreadsheetCellBool
+	spreadsheetCellDate
+	spreadsheetCellDateTime
+	spreadsheetCellNumber
+)
+
+// spreadsheet is the Table laid out as a grid of cells with native values,
+// ready to be written out in a spreadsheet format (like XLSX or ODS).
+type spreadsheet struct {
+	columnWidths  []int // in characters
+	name          string
+	numHeaderRows int
+	rows          [][]spreadsheetCell
+}
+
+// spreadsheetCell is a single cell in a spreadsheet.
+type spreadsheetCell struct {
+	bold      bool
+	covered   bool // merged into another cell to the left or above?
+	kind      spreadsheetCellKind
+	mergeCols int // number of columns merged into this cell (incl. itself)
+	mergeRows int // number of rows merged into this cell (incl. itself)
+	time      time.Time
+	value     string // the text, the number or "true"/"false"
+}
+
+// setValue sets the native value of the cell if the raw value is a number, a
+// time.Time or a bool; the cell is left as text otherwise.
+func (c *spreadsheetCell) setValue(raw interface{}) {
+	switch value := raw.(type) {
+	case bool:
+		c.kind, c.value = spreadsheetCellBool, strconv.FormatBool(value)
+	case time.Time:
+		c.kind, c.time = spreadsheetCellDateTime, value
+		if value.Hour() == 0 && value.Minute() == 0 && value.Second() == 0 && value.Nanosecond() == 0 {
+			c.kind = spreadsheetCellDate
+		}
+	case *time.Time:
+		if value != nil {
+			c.setValue(*value)
+		}
+	default:
+		if raw == nil || !isNumber(raw) {
+			return
+		}
+		v := reflect.ValueOf(raw)
+		switch v.Kind() {
+		case reflect.Float32, reflect.Float64:
+			if math.IsNaN(v.Float()) || math.IsInf(v.Float(), 0) {
+				return
+			}
+			c.value = strconv.FormatFloat(v.Float(), 'f', -1, v.Type().Bits())
+		default:
+			c.value = fmt.Sprint(raw)
+		}
+		c.kind = spreadsheetCellNumber
+	}
+}
+
+// spreadsheetGenerate lays out the Table as a spreadsheet with the header rows
+// (in bold) followed by the regular rows and the footer rows (in bold). Cells
+// get the native values (numbers, dates and booleans) from the raw rows, and
+// cells merged in the text rendering (see ColumnConfig.AutoMerge and
+// RowConfig.AutoMerge) get merged in the spreadsheet too. The title is used as
+// the name of the sheet.
+func (t *Table) spreadsheetGenerate() spreadsheet {
+	t.initForRender()
+
+	sheet := spreadsheet{name: spreadsheetName(t.title)}
+	if t.numColumns == 0 {
+		return sheet
+	}
+
+	if t.autoIndex {
+		sheet.columnWidths = append(sheet.columnWidths, len(fmt.Sprint(len(t.rows))))
+	}
+	sheet.columnWidths = append(sheet.columnWidths, t.maxColumnLengths...)
+
+	if t.autoIndex && len(t.rowsHeader) == 0 {
+		sheet.rows = append(sheet.rows, t.spreadsheetRow(t.getAutoIndexColumnIDs(), nil, renderHint{isAutoIndexRow: true, isHeaderRow: true}))
+	}
+	for rowIdx, row := range t.rowsHeader {
+		sheet.rows = append(sheet.rows, t.spreadsheetRow(row, nil, renderHint{isHeaderRow: true, rowNumber: rowIdx + 1}))
+	}
+	sheet.numHeaderRows = len(sheet.rows)
+	for rowIdx, row := range t.rows {
+		rowRaw := t.rowsRaw[rowIdx]
+		if t.sortedRowIndices != nil {
+			rowRaw = t.rowsRaw[t.sortedRowIndices[rowIdx]]
+		}
+		sheet.rows = append(sheet.rows, t.spreadsheetRow(row, rowRaw, renderHint{rowNumber: rowIdx + 1}))
+	}
+	t.spreadsheetMergeVertically(sheet.rows[sheet.numHeaderRows:])
+	for rowIdx, row := range t.rowsFooter {
+		sheet.rows = append(sheet.rows, t.spreadsheetRow(row, t.rowsFooterRaw[rowIdx], renderHint{isFooterRow: true, rowNumber: rowIdx + 1}))
+	}
+	return sheet
+}
+
+// spreadsheetMergeVertically merges the cells in the regular rows of the
+// columns with ColumnConfig.AutoMerge set, the same way as the text rendering.
+func (t *Table) spreadsheetMergeVertically(rows [][]spreadsheetCell) {
+	offset := 0
+	if t.autoIndex {
+		offset = 1
+	}
+	for colIdx := 0; colIdx < t.numColumns; colIdx++ {
+		if !t.columnConfigMap[colIdx].AutoMerge {
+			continue
+		}
+
+		mergeStartIdx := 0
+		for rowIdx := 1; rowIdx < len(t.rows); rowIdx++ {
+			rowPrev, rowCurr := t.rows[rowIdx-1], t.rows[rowIdx]
+			mergeStart, cell := &rows[mergeStartIdx][colIdx+offset], &rows[rowIdx][colIdx+offset]
+			// cells merged horizontally are left alone to avoid overlaps
+			if colIdx < len(rowPrev) && colIdx < len(rowCurr) &&
+				(rowPrev[colIdx] == rowCurr[colIdx] || rowCurr[colIdx] == "") &&
+				!mergeStart.covered && mergeStart.mergeCols == 1 && !cell.covered && cell.mergeCols == 1 {
+				mergeStart.mergeRows++
+				cell.covered = true
+			} else {
+				mergeStartIdx = rowIdx
+			}
+		}
+	}
+}
+
+// spreadsheetRow returns the cells for the row, with the native values taken
+// from the raw row (if any).
+func (t *Table) spreadsheetRow(row rowStr, rowRaw Row, hint renderHint) []spreadsheetCell {
+	cells := make([]spreadsheetCell, 0, t.numColumns+1)
+	newCell := func(value string) spreadsheetCell {
+		return spreadsheetCell{bold: !hint.isRegularRow(), mergeCols: 1, mergeRows: 1, value: value}
+	}
+
+	if t.autoIndex {
+		cell := newCell("")
+		if hint.isRegularRow() {
+			cell.setValue(hint.rowNumber)
+		}
+		cells = append(cells, cell)
+	}
+
+	rowRaw = t.spreadsheetRowRawVisible(rowRaw)
+	mergeStartIdx := len(cells)
+	autoMerge := !hint.isAutoIndexRow && t.getRowConfig(hint).AutoMerge
+	for colIdx := 0; colIdx < t.numColumns; colIdx++ {
+		cell := newCell("")
+		if colIdx < len(row) {
+			cell.value = text.StripEscape(row[colIdx])
+		}
+		if colIdx < len(rowRaw) {
+			cell.setValue(rowRaw[colIdx])
+		}
+
+		if autoMerge && colIdx > 0 && row.areEqual(colIdx-1, colIdx) {
+			cells[mergeStartIdx].mergeCols++
+			cell.covered = true
+		} else {
+			mergeStartIdx = len(cells)
+		}
+		cells = append(cells, cell)
+	}
+	return cells
+}
+
+// spreadsheetRowRawVisible returns the raw row without the columns hidden
+// using ColumnConfig.Hidden or SuppressEmptyColumns.
+func (t *Table) spreadsheetRowRawVisible(rowRaw Row) Row {
+	if len(t.hiddenColumns) == 0 {
+		return rowRaw
+	}
+	rsp := make(Row, 0, len(rowRaw))
+	for colIdx, col := range rowRaw {
+		if !t.hiddenColumns[colIdx] {
+			rsp = append(rsp, col)
+		}
+	}
+	return rsp
+}
+
+// spreadsheetName returns the title converted into a valid name for a sheet.
+func spreadsheetName(title string) string {
+	name := strings.SplitN(text.StripEscape(title), "\n", 2)[0]
+	name = strings.Map(func(r rune) rune {
+		if strings.ContainsRune("[]:*?/\\", r) {
+			return -1
+		}
+		return r
+	}, name)
+	name = strings.Trim(name, " '")
+	if utf8.RuneCountInString(name) > spreadsheetNameMaxLength {
+		name = strings.TrimRight(string([]rune(name)[:spreadsheetNameMaxLength]), " '")
+	}
+	if name == "" {
+		return spreadsheetNameDefault
+	}
+	return name
+}
+
+// spreadsheetWriteZip writes the files (name and content) in the given order
+// into a ZIP archive; files to be stored without compression are named in
+// uncompressed.
+func spreadsheetWriteZip(w io.Writer, files [][2]string, uncompressed ...string) error {
+	zipWriter := zip.NewWriter(w)
+	for _, file := range files {
+		header := &zip.FileHeader{Name: file[0], Method: zip.Deflate}
+		for _, name := range uncompressed {
+			if name == file[0] {
+				header.Method = zip.Store
+			}
+		}
+		fileWriter, err := zipWriter.CreateHeader(header)
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(fileWriter, file[1]); err != nil {
+			return err
+		}
+	}
+	return zipWriter.Close()
+}
+
+// xmlEscape escapes the text for use in XML content and attribute values.
+func xmlEscape(str string) string {
+	var out bytes.Buffer
+	_ = xml.EscapeText(&out, []byte(str))
+	return out.String()
+}
@@ -0,0 +1,180 @@
+package table
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"io"
+	"io/ioutil"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/stretchr/testify/assert"
+)
+
+// readZip returns the names of the files (in order) and their contents from
+// the ZIP archive, after ensuring all the XML files are well-formed.
+func readZip(t *testing.T, data []byte) ([]string, map[string]string) {
+	zipReader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if !assert.Nil(t, err) {
+		return nil, nil
+	}
+
+	var names []string
+	contents := make(map[string]string)
+	for _, file := range zipReader.File {
+		reader, err := file.Open()
+		assert.Nil(t, err)
+		content, err := ioutil.ReadAll(reader)
+		assert.Nil(t, err)
+		_ = reader.Close()
+
+		names = append(names, file.Name)
+		contents[file.Name] = string(content)
+		if file.Name != "mimetype" {
+			decoder := xml.NewDecoder(bytes.NewReader(content))
+			for {
+				if _, err := decoder.Token(); err != nil {
+					assert.Equal(t, io.EOF, err, file.Name)
+					break
+				}
+			}
+		}
+	}
+	return names, contents
+}
+
+func TestTable_spreadsheetGenerate(t *testing.T) {
+	date := time.Date(2021, time.March, 4, 0, 0, 0, 0, time.UTC)
+	dateTime := time.Date(2021, time.March, 4, 5, 6, 7, 0, time.UTC)
+
+	tw := Table{}
+	tw.AppendHeader(Row{"#", "Name", "Hidden", "Born", "Alive", "Salary"})
+	tw.AppendRow(Row{20, "Jon", "x", &dateTime, true, 2000.5})
+	tw.AppendRow(Row{1, text.FgRed.Sprint("Arya"), "x", date, false, math.NaN()})
+	tw.AppendFooter(Row{"", "", "", "", "Total", 2000.5})
+	tw.SetColumnConfigs([]ColumnConfig{{Name: "Hidden", Hidden: true}})
+	tw.SetTitle("Game of [Thrones]: Season 1 - The Beginning")
+	tw.SortBy([]SortBy{{Name: "#", Mode: AscNumeric}})
+
+	sheet := tw.spreadsheetGenerate()
+	assert.Equal(t, "Game of Thrones Season 1 - The", sheet.name)
+	assert.Equal(t, []int{2, 4, 29, 5, 6}, sheet.columnWidths)
+	assert.Equal(t, 1, sheet.numHeaderRows)
+	if !assert.Len(t, sheet.rows, 4) {
+		return
+	}
+
+	cell := func(value string, kind spreadsheetCellKind, bold bool) spreadsheetCell {
+		return spreadsheetCell{bold: bold, kind: kind, mergeCols: 1, mergeRows: 1, value: value}
+	}
+	cellTime := func(value string, kind spreadsheetCellKind, t time.Time) spreadsheetCell {
+		c := cell(value, kind, false)
+		c.time = t
+		return c
+	}
+	assert.Equal(t, []spreadsheetCell{
+		cell("#", spreadsheetCellString, true),
+		cell("Name", spreadsheetCellString, true),
+		cell("Born", spreadsheetCellString, true),
+		cell("Alive", spreadsheetCellString, true),
+		cell("Salary", spreadsheetCellString, true),
+	}, sheet.rows[0])
+	// sorted by the "#" column, with the values from the raw rows
+	assert.Equal(t, []spreadsheetCell{
+		cell("1", spreadsheetCellNumber, false),
+		cell("Arya", spreadsheetCellString, false),
+		cellTime(date.String(), spreadsheetCellDate, date),
+		cell("false", spreadsheetCellBool, false),
+		cell("NaN", spreadsheetCellString, false),
+	}, sheet.rows[1])
+	assert.Equal(t, []spreadsheetCell{
+		cell("20", spreadsheetCellNumber, false),
+		cell("Jon", spreadsheetCellString, false),
+		cellTime(dateTime.String(), spreadsheetCellDateTime, dateTime),
+		cell("true", spreadsheetCellBool, false),
+		cell("2000.5", spreadsheetCellNumber, false),
+	}, sheet.rows[2])
+	assert.Equal(t, []spreadsheetCell{
+		cell("", spreadsheetCellString, true),
+		cell("", spreadsheetCellString, true),
+		cell("", spreadsheetCellString, true),
+		cell("Total", spreadsheetCellString, true),
+		cell("2000.5", spreadsheetCellNumber, true),
+	}, sheet.rows[3])
+}
+
+func TestTable_spreadsheetGenerate_AutoIndex(t *testing.T) {
+	tw := Table{}
+	tw.AppendRow(Row{"Arya", "Stark"})
+	tw.AppendRow(Row{"Jon", "Snow"})
+	tw.SetAutoIndex(true)
+
+	sheet := tw.spreadsheetGenerate()
+	assert.Equal(t, "Sheet1", sheet.name)
+	assert.Equal(t, []int{1, 4, 5}, sheet.columnWidths)
+	assert.Equal(t, 1, sheet.numHeaderRows)
+	if !assert.Len(t, sheet.rows, 3) {
+		return
+	}
+	values := func(row []spreadsheetCell) []string {
+		var rsp []string
+		for _, cell := range row {
+			rsp = append(rsp, cell.value)
+		}
+		return rsp
+	}
+	assert.Equal(t, []string{"", "A", "B"}, values(sheet.rows[0]))
+	assert.Equal(t, []string{"1", "Arya", "Stark"}, values(sheet.rows[1]))
+	assert.Equal(t, []string{"2", "Jon", "Snow"}, values(sheet.rows[2]))
+	assert.Equal(t, spreadsheetCellNumber, sheet.rows[2][0].kind)
+}
+
+func TestTable_spreadsheetGenerate_AutoMerge(t *testing.T) {
+	tw := Table{}
+	tw.AppendHeader(Row{"Node", "Node", "Status"}, RowConfig{AutoMerge: true})
+	tw.AppendRow(Row{"1.1.1.1", "Pod 1A", "Y"})
+	tw.AppendRow(Row{"1.1.1.1", "Pod 1B", "Y"})
+	tw.AppendRow(Row{"1.1.1.1", "Pod 1C", "N"})
+	tw.AppendRow(Row{"2.2.2.2", "Pod 2A", "N"})
+	tw.SetColumnConfigs([]ColumnConfig{{Number: 1, AutoMerge: true}, {Number: 3, AutoMerge: true}})
+
+	type merge struct{ cols, rows int }
+	merges := map[[2]int]merge{}
+	var covered [][2]int
+	for rowIdx, row := range tw.spreadsheetGenerate().rows {
+		for colIdx, cell := range row {
+			if cell.covered {
+				covered = append(covered, [2]int{rowIdx, colIdx})
+			} else if cell.mergeCols > 1 || cell.mergeRows > 1 {
+				merges[[2]int{rowIdx, colIdx}] = merge{cols: cell.mergeCols, rows: cell.mergeRows}
+			}
+		}
+	}
+	assert.Equal(t, map[[2]int]merge{
+		{0, 0}: {cols: 2, rows: 1},
+		{1, 0}: {cols: 1, rows: 3},
+		{1, 2}: {cols: 1, rows: 2},
+		{3, 2}: {cols: 1, rows: 2},
+	}, merges)
+	assert.Equal(t, [][2]int{{0, 1}, {2, 0}, {2, 2}, {3, 0}, {4, 2}}, covered)
+}
+
+func TestTable_spreadsheetGenerate_Empty(t *testing.T) {
+	tw := Table{}
+	sheet := tw.spreadsheetGenerate()
+	assert.Equal(t, "Sheet1", sheet.name)
+	assert.Empty(t, sheet.columnWidths)
+	assert.Empty(t, sheet.rows)
+}
+
+func TestSpreadsheetName(t *testing.T) {
+	assert.Equal(t, "Sheet1", spreadsheetName(""))
+	assert.Equal(t, "Sheet1", spreadsheetName("[?]"))
+	assert.Equal(t, "Game of Thrones", spreadsheetName("Game of Thrones"))
+	assert.Equal(t, "Game of Thrones", spreadsheetName(text.FgRed.Sprint("'Game of Thrones'")+"\nSeason 1"))
+	assert.Equal(t, "Game of Thrones - Season 1 and", spreadsheetName("Game of Thrones - Season 1 and 2"))
+	assert.Equal(t, "Winterfellツ", spreadsheetName("Winterfell/ツ*"))
+}
@@ -2,6 +2,7 @@ package table
 
 import (
 	"fmt"
+	"math"
 	"sort"
 	"strings"
 	"testing"
@@ -1291,3 +1292,143 @@ func TestTable_Render_SetWidth_Title(t *testing.T) {
 	})
 
 }
+
+func TestTable_Render_WidthEnforcers(t *testing.T) {
+	tw := NewWriter()
+	tw.AppendHeader(Row{"ID", "Path", "Link"})
+	tw.AppendRow(Row{"a1b2c3d4e5f6", "/usr/local/bin", "see https://x.io/y"})
+	tw.AppendRow(Row{"a1b2", "/opt", "nothing to see here"})
+	tw.SetColumnConfigs([]ColumnConfig{
+		{Name: "ID", WidthMax: 7, WidthMaxEnforcer: WidthEnforcerSnipLeft},
+		{Name: "Path", WidthMax: 10, WidthMaxEnforcer: WidthEnforcerSnipMiddle},
+		{Name: "Link", WidthMax: 8, WidthMaxEnforcer: WidthEnforcerKeepURLs},
+	})
+
+	expectedOut := `+---------+------------+----------------+
+| ID      | PATH       | LINK           |
++---------+------------+----------------+
+| …d4e5f6 | /usr/…/bin | see            |
+|         |            | https://x.io/y |
+| a1b2    | /opt       | nothing        |
+|         |            | to see         |
+|         |            | here           |
++---------+------------+----------------+`
+	assert.Equal(t, expectedOut, tw.Render())
+}
+
+func TestTable_Render_ColorRules(t *testing.T) {
+	tw := NewWriter()
+	tw.AppendHeader(testHeader)
+	tw.AppendRows(testRows)
+	tw.AppendFooter(testFooter)
+	tw.SetColumnConfigs([]ColumnConfig{
+		{Name: "First Name", Hidden: true},
+		{Name: "Salary", ColorRules: []ColorRule{
+			ColorRuleGreaterThan(2500, text.Colors{text.FgRed}),
+			ColorRuleMax(text.Colors{text.Bold}),
+		}},
+	})
+	tw.SetStyle(StyleLight)
+	tw.SortBy([]SortBy{{Name: "Salary", Mode: DscNumeric}})
+
+	expectedOut := `┌─────┬───────────┬────────┬─────────────────────────────┐
+│   # │ LAST NAME │ SALARY │                             │
+├─────┼───────────┼────────┼─────────────────────────────┤
+│ 300 │ Lannister │` + "\x1b[31;1m   5000 \x1b[0m" + `│                             │
+│   1 │ Stark     │` + "\x1b[31m   3000 \x1b[0m" + `│                             │
+│  20 │ Snow      │   2000 │ You know nothing, Jon Snow! │
+├─────┼───────────┼────────┼─────────────────────────────┤
+│     │ TOTAL     │  10000 │                             │
+└─────┴───────────┴────────┴─────────────────────────────┘`
+	assert.Equal(t, expectedOut, tw.Render())
+
+	tw.Style().Color.Row = text.Colors{text.BgBlack}
+	tw.Style().Color.RowAlternate = text.Colors{text.BgBlack}
+	assert.Contains(t, tw.Render(), "\x1b[40;31;1m   5000 \x1b[0m")
+	assert.Contains(t, tw.Render(), "\x1b[40m   2000 \x1b[0m")
+}
+
+func TestTable_Render_DataBarsAndSparklines(t *testing.T) {
+	tw := NewWriter()
+	tw.AppendHeader(Row{"Host", "Region", "Usage", "Trend"})
+	tw.AppendRows([]Row{
+		{"alpha", "eu", 80, []float64{1, 2, 4, 8}},
+		{"beta", "us", 5, []int{3, 3, 3}},
+		{"gamma", "us", 100, "n/a"},
+	})
+	tw.SetColumnConfigs([]ColumnConfig{
+		{Name: "Region", Hidden: true},
+		{Name: "Usage", DataBar: &DataBar{Width: 5}},
+		{Name: "Trend", Sparkline: &Sparkline{}},
+	})
+	tw.SetStyle(StyleLight)
+	tw.SortBy([]SortBy{{Name: "Usage", Mode: DscNumeric}})
+
+	expectedOut := `┌───────┬───────────┬───────┐
+│ HOST  │     USAGE │ TREND │
+├───────┼───────────┼───────┤
+│ gamma │ █████ 100 │ n/a   │
+│ alpha │ ████   80 │ ▁▂▄█  │
+│ beta  │ ▎       5 │ ▁▁▁   │
+└───────┴───────────┴───────┘`
+	assert.Equal(t, expectedOut, tw.Render())
+
+	tw.SetColumnConfigs([]ColumnConfig{
+		{Name: "Region", Hidden: true},
+		{Name: "Usage", DataBar: &DataBar{Colors: text.Colors{text.FgGreen}, HideValue: true, Width: 5}},
+		{Name: "Trend", Sparkline: &Sparkline{Colors: text.Colors{text.FgRed}}},
+	})
+	expectedOut = `┌───────┬───────┬───────┐
+│ HOST  │ USAGE │ TREND │
+├───────┼───────┼───────┤
+│ gamma │ ` + "\x1b[32m█████\x1b[0m" + ` │ n/a   │
+│ alpha │ ` + "\x1b[32m████ \x1b[0m" + ` │ ` + "\x1b[31m▁▂▄█\x1b[0m" + `  │
+│ beta  │ ` + "\x1b[32m▎    \x1b[0m" + ` │ ` + "\x1b[31m▁▁▁\x1b[0m" + `   │
+└───────┴───────┴───────┘`
+	assert.Equal(t, expectedOut, tw.Render())
+}
+
+func TestTable_Render_Hyperlinks(t *testing.T) {
+	tw := NewWriter()
+	tw.AppendHeader(Row{"Name", "Home"})
+	tw.AppendRow(Row{"go-pretty", text.Hyperlink("https://github.com/jedib0t/go-pretty", "jedib0t/go-pretty on GitHub")})
+	tw.AppendRow(Row{"Go", text.Hyperlink("https://go.dev", "go.dev")})
+	tw.SetColumnConfigs([]ColumnConfig{{Name: "Home", WidthMax: 10}})
+	tw.SetStyle(StyleLight)
+
+	expectedOut := strings.Join([]string{
+		"┌───────────┬────────────┐",
+		"│ NAME      │ HOME       │",
+		"├───────────┼────────────┤",
+		"│ go-pretty │ \x1b]8;;https://github.com/jedib0t/go-pretty\x1b\\jedib0t/go\x1b]8;;\x1b\\ │",
+		"│           │ \x1b]8;;https://github.com/jedib0t/go-pretty\x1b\\-pretty on\x1b]8;;\x1b\\ │",
+		"│           │ \x1b]8;;https://github.com/jedib0t/go-pretty\x1b\\ GitHub\x1b]8;;\x1b\\    │",
+		"│ Go        │ \x1b]8;;https://go.dev\x1b\\go.dev\x1b]8;;\x1b\\     │",
+		"└───────────┴────────────┘",
+	}, "\n")
+	assert.Equal(t, expectedOut, tw.Render())
+}
+
+func TestTable_Render_DataBarsWithNonFiniteValues(t *testing.T) {
+	tw := NewWriter()
+	tw.AppendHeader(Row{"Host", "Usage"})
+	tw.AppendRows([]Row{
+		{"alpha", 1.0},
+		{"beta", math.NaN()},
+		{"gamma", math.Inf(1)},
+		{"delta", 4.0},
+	})
+	tw.SetColumnConfigs([]ColumnConfig{{Name: "Usage", DataBar: &DataBar{Width: 4}}})
+	tw.SetStyle(StyleLight)
+
+	// NaN and infinite values get no bar, and do not affect the other bars
+	expectedOut := `┌───────┬───────────┐
+│ HOST  │     USAGE │
+├───────┼───────────┤
+│ alpha │ █       1 │
+│ beta  │       NaN │
+│ gamma │      +Inf │
+│ delta │ ████    4 │
+└───────┴───────────┘`
+	assert.Equal(t, expectedOut, tw.Render())
+}
@@ -0,0 +1,184 @@
+package table
+
+import (
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	xlsxXMLHeader = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` + "\n"
+
+	xlsxContentTypes = xlsxXMLHeader + `<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">
+<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>
+<Default Extension="xml" ContentType="application/xml"/>
+<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>
+<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>
+<Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/>
+</Types>`
+
+	xlsxRels = xlsxXMLHeader + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>
+</Relationships>`
+
+	xlsxWorkbookRels = xlsxXMLHeader + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>
+<Relationship Id="rId2" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>
+</Relationships>`
+
+	// xlsxStyles defines the cell formats (in cellXfs) referred to by the
+	// cells; see xlsxCellStyle for the order
+	xlsxStyles = xlsxXMLHeader + `<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">
+<numFmts count="2"><numFmt numFmtId="164" formatCode="yyyy-mm-dd"/><numFmt numFmtId="165" formatCode="yyyy-mm-dd hh:mm:ss"/></numFmts>
+<fonts count="2"><font><sz val="11"/><name val="Calibri"/></font><font><b/><sz val="11"/><name val="Calibri"/></font></fonts>
+<fills count="2"><fill><patternFill patternType="none"/></fill><fill><patternFill patternType="gray125"/></fill></fills>
+<borders count="1"><border><left/><right/><top/><bottom/><diagonal/></border></borders>
+<cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs>
+<cellXfs count="6">
+<xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/>
+<xf numFmtId="0" fontId="1" fillId="0" borderId="0" xfId="0" applyFont="1"/>
+<xf numFmtId="164" fontId="0" fillId="0" borderId="0" xfId="0" applyNumberFormat="1"/>
+<xf numFmtId="164" fontId="1" fillId="0" borderId="0" xfId="0" applyFont="1" applyNumberFormat="1"/>
+<xf numFmtId="165" fontId="0" fillId="0" borderId="0" xfId="0" applyNumberFormat="1"/>
+<xf numFmtId="165" fontId="1" fillId="0" borderId="0" xfId="0" applyFont="1" applyNumberFormat="1"/>
+</cellXfs>
+<cellStyles count="1"><cellStyle name="Normal" xfId="0" builtinId="0"/></cellStyles>
+</styleSheet>`
+)
+
+var (
+	// xlsxEpoch is the date that the (1900 date system) serial numbers used
+	// for dates in XLSX files count the days from
+	xlsxEpoch = time.Date(1899, time.December, 30, 0, 0, 0, 0, time.UTC)
+)
+
+// RenderXLSX renders the Table as an Excel (Office Open XML) workbook with a
+// single sheet into the given io.Writer. The header rows are in bold and frozen
+// in place; numbers, booleans and dates (time.Time) in the rows get stored as
+// native values, while everything else is stored as the text that Render
+// would show (without any colors). The widths of the columns match the text
+// rendering, the cells merged using ColumnConfig.AutoMerge or
+// RowConfig.AutoMerge are merged in the sheet too, and the title is used as the
+// name of the sheet. The workbook is not written to the output mirror (if any).
+func (t *Table) RenderXLSX(w io.Writer) error {
+	sheet := t.spreadsheetGenerate()
+
+	workbook := xlsxXMLHeader + `<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"` +
+		` xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` + "\n" +
+		`<sheets><sheet name="` + xmlEscape(sheet.name) + `" sheetId="1" r:id="rId1"/></sheets>` + "\n" +
+		`</workbook>`
+
+	return spreadsheetWriteZip(w, [][2]string{
+		{"[Content_Types].xml", xlsxContentTypes},
+		{"_rels/.rels", xlsxRels},
+		{"xl/workbook.xml", workbook},
+		{"xl/_rels/workbook.xml.rels", xlsxWorkbookRels},
+		{"xl/styles.xml", xlsxStyles},
+		{"xl/worksheets/sheet1.xml", xlsxWorksheet(sheet)},
+	})
+}
+
+// xlsxCellRef returns the reference (ex.: "B3") to the cell.
+func xlsxCellRef(rowIdx, colIdx int) string {
+	return AutoIndexColumnID(colIdx) + strconv.Itoa(rowIdx+1)
+}
+
+// xlsxCellStyle returns the index of the cell format (in xlsxStyles) to use
+// for the cell.
+func xlsxCellStyle(cell spreadsheetCell) int {
+	style := 0
+	switch cell.kind {
+	case spreadsheetCellDate:
+		style = 2
+	case spreadsheetCellDateTime:
+		style = 4
+	}
+	if cell.bold {
+		style++
+	}
+	return style
+}
+
+// xlsxDateSerial returns the serial number (days since xlsxEpoch) for the
+// date and time as seen on a wall clock in its time zone.
+func xlsxDateSerial(t time.Time) string {
+	wallClock := time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+	days := float64(wallClock.Unix()-xlsxEpoch.Unix())/86400 + float64(wallClock.Nanosecond())/(86400*1e9)
+	return strconv.FormatFloat(days, 'f', -1, 64)
+}
+
+// xlsxWorksheet returns the XML for the worksheet with the contents of the
+// spreadsheet.
+func xlsxWorksheet(sheet spreadsheet) string {
+	var out strings.Builder
+	out.WriteString(xlsxXMLHeader)
+	out.WriteString(`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"`)
+	out.WriteString(` xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` + "\n")
+	if sheet.numHeaderRows > 0 && sheet.numHeaderRows < len(sheet.rows) {
+		out.WriteString(`<sheetViews><sheetView workbookViewId="0"><pane ySplit="` + strconv.Itoa(sheet.numHeaderRows))
+		out.WriteString(`" topLeftCell="` + xlsxCellRef(sheet.numHeaderRows, 0) + `" activePane="bottomLeft" state="frozen"/>`)
+		out.WriteString("</sheetView></sheetViews>\n")
+	}
+	if len(sheet.columnWidths) > 0 {
+		out.WriteString("<cols>")
+		for colIdx, width := range sheet.columnWidths {
+			colNum := strconv.Itoa(colIdx + 1)
+			out.WriteString(`<col min="` + colNum + `" max="` + colNum + `" width="` + strconv.Itoa(width+2) + `" customWidth="1"/>`)
+		}
+		out.WriteString("</cols>\n")
+	}
+
+	var mergeCells []string
+	out.WriteString("<sheetData>\n")
+	for rowIdx, row := range sheet.rows {
+		out.WriteString(`<row r="` + strconv.Itoa(rowIdx+1) + `">`)
+		for colIdx, cell := range row {
+			if cell.covered {
+				continue
+			}
+			if cell.mergeCols > 1 || cell.mergeRows > 1 {
+				mergeCells = append(mergeCells, xlsxCellRef(rowIdx, colIdx)+":"+xlsxCellRef(rowIdx+cell.mergeRows-1, colIdx+cell.mergeCols-1))
+			}
+			xlsxWriteCell(&out, cell, xlsxCellRef(rowIdx, colIdx))
+		}
+		out.WriteString("</row>\n")
+	}
+	out.WriteString("</sheetData>\n")
+	if len(mergeCells) > 0 {
+		out.WriteString(`<mergeCells count="` + strconv.Itoa(len(mergeCells)) + `">`)
+		for _, mergeCell := range mergeCells {
+			out.WriteString(`<mergeCell ref="` + mergeCell + `"/>`)
+		}
+		out.WriteString("</mergeCells>\n")
+	}
+	out.WriteString("</worksheet>")
+	return out.String()
+}
+
+// xlsxWriteCell writes the XML for the cell with the given reference; empty
+// cells are not written out at all.
+func xlsxWriteCell(out *strings.Builder, cell spreadsheetCell, ref string) {
+	if cell.kind == spreadsheetCellString && cell.value == "" {
+		return
+	}
+
+	out.WriteString(`<c r="` + ref + `"`)
+	if style := xlsxCellStyle(cell); style > 0 {
+		out.WriteString(` s="` + strconv.Itoa(style) + `"`)
+	}
+	switch cell.kind {
+	case spreadsheetCellBool:
+		value := "0"
+		if cell.value == "true" {
+			value = "1"
+		}
+		out.WriteString(` t="b"><v>` + value + `</v></c>`)
+	case spreadsheetCellDate, spreadsheetCellDateTime:
+		out.WriteString(`><v>` + xlsxDateSerial(cell.time) + `</v></c>`)
+	case spreadsheetCellNumber:
+		out.WriteString(`><v>` + cell.value + `</v></c>`)
+	default:
+		out.WriteString(` t="inlineStr"><is><t xml:space="preserve">` + xmlEscape(cell.value) + `</t></is></c>`)
+	}
+}
@@ -0,0 +1,102 @@
+package table
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTable_RenderXLSX(t *testing.T) {
+	tw := NewWriter()
+	tw.AppendHeader(Row{"#", "Name", "Name", "Born", "Alive"}, RowConfig{AutoMerge: true})
+	tw.AppendRow(Row{1, "Arya", "Stark", time.Date(2000, time.March, 1, 0, 0, 0, 0, time.UTC), true})
+	tw.AppendRow(Row{20, "Jon", "Snow <Targaryen>", time.Date(2000, time.March, 1, 12, 0, 0, 0, time.UTC), false})
+	tw.AppendFooter(Row{"", "", "Total", 21.5})
+	tw.SetTitle("Game of Thrones")
+	mirror := strings.Builder{}
+	tw.SetOutputMirror(&mirror)
+
+	var out bytes.Buffer
+	assert.Nil(t, tw.RenderXLSX(&out))
+	assert.Empty(t, mirror.String())
+
+	names, contents := readZip(t, out.Bytes())
+	assert.Equal(t, []string{
+		"[Content_Types].xml",
+		"_rels/.rels",
+		"xl/workbook.xml",
+		"xl/_rels/workbook.xml.rels",
+		"xl/styles.xml",
+		"xl/worksheets/sheet1.xml",
+	}, names)
+	assert.Contains(t, contents["xl/workbook.xml"], `<sheet name="Game of Thrones" sheetId="1" r:id="rId1"/>`)
+
+	expectedSheet := strings.Join([]string{
+		`<?xml version="1.0" encoding="UTF-8" standalone="yes"?>`,
+		`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">`,
+		`<sheetViews><sheetView workbookViewId="0"><pane ySplit="1" topLeftCell="A2" activePane="bottomLeft" state="frozen"/></sheetView></sheetViews>`,
+		`<cols>` +
+			`<col min="1" max="1" width="4" customWidth="1"/>` +
+			`<col min="2" max="2" width="6" customWidth="1"/>` +
+			`<col min="3" max="3" width="18" customWidth="1"/>` +
+			`<col min="4" max="4" width="31" customWidth="1"/>` +
+			`<col min="5" max="5" width="7" customWidth="1"/>` +
+			`</cols>`,
+		`<sheetData>`,
+		`<row r="1">` +
+			`<c r="A1" s="1" t="inlineStr"><is><t xml:space="preserve">#</t></is></c>` +
+			`<c r="B1" s="1" t="inlineStr"><is><t xml:space="preserve">Name</t></is></c>` +
+			`<c r="D1" s="1" t="inlineStr"><is><t xml:space="preserve">Born</t></is></c>` +
+			`<c r="E1" s="1" t="inlineStr"><is><t xml:space="preserve">Alive</t></is></c>` +
+			`</row>`,
+		`<row r="2">` +
+			`<c r="A2"><v>1</v></c>` +
+			`<c r="B2" t="inlineStr"><is><t xml:space="preserve">Arya</t></is></c>` +
+			`<c r="C2" t="inlineStr"><is><t xml:space="preserve">Stark</t></is></c>` +
+			`<c r="D2" s="2"><v>36586</v></c>` +
+			`<c r="E2" t="b"><v>1</v></c>` +
+			`</row>`,
+		`<row r="3">` +
+			`<c r="A3"><v>20</v></c>` +
+			`<c r="B3" t="inlineStr"><is><t xml:space="preserve">Jon</t></is></c>` +
+			`<c r="C3" t="inlineStr"><is><t xml:space="preserve">Snow &lt;Targaryen&gt;</t></is></c>` +
+			`<c r="D3" s="4"><v>36586.5</v></c>` +
+			`<c r="E3" t="b"><v>0</v></c>` +
+			`</row>`,
+		`<row r="4">` +
+			`<c r="C4" s="1" t="inlineStr"><is><t xml:space="preserve">Total</t></is></c>` +
+			`<c r="D4" s="1"><v>21.5</v></c>` +
+			`</row>`,
+		`</sheetData>`,
+		`<mergeCells count="1"><mergeCell ref="B1:C1"/></mergeCells>`,
+		`</worksheet>`,
+	}, "\n")
+	assert.Equal(t, expectedSheet, contents["xl/worksheets/sheet1.xml"])
+}
+
+func TestTable_RenderXLSX_Empty(t *testing.T) {
+	tw := NewWriter()
+
+	var out bytes.Buffer
+	assert.Nil(t, tw.RenderXLSX(&out))
+
+	_, contents := readZip(t, out.Bytes())
+	assert.Contains(t, contents["xl/workbook.xml"], `<sheet name="Sheet1" sheetId="1" r:id="rId1"/>`)
+	assert.Equal(t, strings.Join([]string{
+		`<?xml version="1.0" encoding="UTF-8" standalone="yes"?>`,
+		`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">`,
+		`<sheetData>`,
+		`</sheetData>`,
+		`</worksheet>`,
+	}, "\n"), contents["xl/worksheets/sheet1.xml"])
+}
+
+func TestXLSXDateSerial(t *testing.T) {
+	assert.Equal(t, "1", xlsxDateSerial(time.Date(1899, time.December, 31, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, "36586.25", xlsxDateSerial(time.Date(2000, time.March, 1, 6, 0, 0, 0, time.UTC)))
+	// the wall clock time is used irrespective of the time zone
+	assert.Equal(t, "36586.25", xlsxDateSerial(time.Date(2000, time.March, 1, 6, 0, 0, 0, time.FixedZone("IST", 19800))))
+}
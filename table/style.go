@@ -7,13 +7,15 @@ import (
 // Style declares how to render the Table and provides very fine-grained control
 // on how the Table gets rendered on the Console.
 type Style struct {
-	Name    string        // name of the Style
-	Box     BoxStyle      // characters to use for the boxes
-	Color   ColorOptions  // colors to use for the rows and columns
-	Format  FormatOptions // formatting options for the rows and columns
-	HTML    HTMLOptions   // rendering options for HTML mode
-	Options Options       // misc. options for the table
-	Title   TitleOptions  // formation options for the title text
+	Name     string            // name of the Style
+	Box      BoxStyle          // characters to use for the boxes
+	Color    ColorOptions      // colors to use for the rows and columns
+	Format   FormatOptions     // formatting options for the rows and columns
+	HTML     HTMLOptions       // rendering options for HTML mode
+	Image    text.ImageOptions // rendering options for SVG/PNG images
+	Markdown MarkdownOptions   // rendering options for Markdown mode
+	Options  Options           // misc. options for the table
+	Title    TitleOptions      // formation options for the title text
 }
 
 var (
@@ -28,13 +30,14 @@ var (
 	//  |     |            | TOTAL     |  10000 |                             |
 	//  +-----+------------+-----------+--------+-----------------------------+
 	StyleDefault = Style{
-		Name:    "StyleDefault",
-		Box:     StyleBoxDefault,
-		Color:   ColorOptionsDefault,
-		Format:  FormatOptionsDefault,
-		HTML:    DefaultHTMLOptions,
-		Options: OptionsDefault,
-		Title:   TitleOptionsDefault,
+		Name:     "StyleDefault",
+		Box:      StyleBoxDefault,
+		Color:    ColorOptionsDefault,
+		Format:   FormatOptionsDefault,
+		HTML:     DefaultHTMLOptions,
+		Markdown: DefaultMarkdownOptions,
+		Options:  OptionsDefault,
+		Title:    TitleOptionsDefault,
 	}
 
 	// StyleBold renders a Table like below:
@@ -48,195 +51,210 @@ var (
 	//  ┃     ┃            ┃ TOTAL     ┃  10000 ┃                             ┃
 	//  ┗━━━━━┻━━━━━━━━━━━━┻━━━━━━━━━━━┻━━━━━━━━┻━━━━━━━━━━━━━━━━━━━━━━━━━━━━━┛
 	StyleBold = Style{
-		Name:    "StyleBold",
-		Box:     StyleBoxBold,
-		Color:   ColorOptionsDefault,
-		Format:  FormatOptionsDefault,
-		HTML:    DefaultHTMLOptions,
-		Options: OptionsDefault,
-		Title:   TitleOptionsDefault,
+		Name:     "StyleBold",
+		Box:      StyleBoxBold,
+		Color:    ColorOptionsDefault,
+		Format:   FormatOptionsDefault,
+		HTML:     DefaultHTMLOptions,
+		Markdown: DefaultMarkdownOptions,
+		Options:  OptionsDefault,
+		Title:    TitleOptionsDefault,
 	}
 
 	// StyleColoredBright renders a Table without any borders or separators,
 	// and with Black text on Cyan background for Header/Footer and
 	// White background for other rows.
 	StyleColoredBright = Style{
-		Name:    "StyleColoredBright",
-		Box:     StyleBoxDefault,
-		Color:   ColorOptionsBright,
-		Format:  FormatOptionsDefault,
-		HTML:    DefaultHTMLOptions,
-		Options: OptionsNoBordersAndSeparators,
-		Title:   TitleOptionsDark,
+		Name:     "StyleColoredBright",
+		Box:      StyleBoxDefault,
+		Color:    ColorOptionsBright,
+		Format:   FormatOptionsDefault,
+		HTML:     DefaultHTMLOptions,
+		Markdown: DefaultMarkdownOptions,
+		Options:  OptionsNoBordersAndSeparators,
+		Title:    TitleOptionsDark,
 	}
 
 	// StyleColoredDark renders a Table without any borders or separators, and
 	// with Header/Footer in Cyan text and other rows with White text, all on
 	// Black background.
 	StyleColoredDark = Style{
-		Name:    "StyleColoredDark",
-		Box:     StyleBoxDefault,
-		Color:   ColorOptionsDark,
-		Format:  FormatOptionsDefault,
-		HTML:    DefaultHTMLOptions,
-		Options: OptionsNoBordersAndSeparators,
-		Title:   TitleOptionsBright,
+		Name:     "StyleColoredDark",
+		Box:      StyleBoxDefault,
+		Color:    ColorOptionsDark,
+		Format:   FormatOptionsDefault,
+		HTML:     DefaultHTMLOptions,
+		Markdown: DefaultMarkdownOptions,
+		Options:  OptionsNoBordersAndSeparators,
+		Title:    TitleOptionsBright,
 	}
 
 	// StyleColoredBlackOnBlueWhite renders a Table without any borders or
 	// separators, and with Black text on Blue background for Header/Footer and
 	// White background for other rows.
 	StyleColoredBlackOnBlueWhite = Style{
-		Name:    "StyleColoredBlackOnBlueWhite",
-		Box:     StyleBoxDefault,
-		Color:   ColorOptionsBlackOnBlueWhite,
-		Format:  FormatOptionsDefault,
-		HTML:    DefaultHTMLOptions,
-		Options: OptionsNoBordersAndSeparators,
-		Title:   TitleOptionsBlueOnBlack,
+		Name:     "StyleColoredBlackOnBlueWhite",
+		Box:      StyleBoxDefault,
+		Color:    ColorOptionsBlackOnBlueWhite,
+		Format:   FormatOptionsDefault,
+		HTML:     DefaultHTMLOptions,
+		Markdown: DefaultMarkdownOptions,
+		Options:  OptionsNoBordersAndSeparators,
+		Title:    TitleOptionsBlueOnBlack,
 	}
 
 	// StyleColoredBlackOnCyanWhite renders a Table without any borders or
 	// separators, and with Black text on Cyan background for Header/Footer and
 	// White background for other rows.
 	StyleColoredBlackOnCyanWhite = Style{
-		Name:    "StyleColoredBlackOnCyanWhite",
-		Box:     StyleBoxDefault,
-		Color:   ColorOptionsBlackOnCyanWhite,
-		Format:  FormatOptionsDefault,
-		HTML:    DefaultHTMLOptions,
-		Options: OptionsNoBordersAndSeparators,
-		Title:   TitleOptionsCyanOnBlack,
+		Name:     "StyleColoredBlackOnCyanWhite",
+		Box:      StyleBoxDefault,
+		Color:    ColorOptionsBlackOnCyanWhite,
+		Format:   FormatOptionsDefault,
+		HTML:     DefaultHTMLOptions,
+		Markdown: DefaultMarkdownOptions,
+		Options:  OptionsNoBordersAndSeparators,
+		Title:    TitleOptionsCyanOnBlack,
 	}
 
 	// StyleColoredBlackOnGreenWhite renders a Table without any borders or
 	// separators, and with Black text on Green background for Header/Footer and
 	// White background for other rows.
 	StyleColoredBlackOnGreenWhite = Style{
-		Name:    "StyleColoredBlackOnGreenWhite",
-		Box:     StyleBoxDefault,
-		Color:   ColorOptionsBlackOnGreenWhite,
-		Format:  FormatOptionsDefault,
-		HTML:    DefaultHTMLOptions,
-		Options: OptionsNoBordersAndSeparators,
-		Title:   TitleOptionsGreenOnBlack,
+		Name:     "StyleColoredBlackOnGreenWhite",
+		Box:      StyleBoxDefault,
+		Color:    ColorOptionsBlackOnGreenWhite,
+		Format:   FormatOptionsDefault,
+		HTML:     DefaultHTMLOptions,
+		Markdown: DefaultMarkdownOptions,
+		Options:  OptionsNoBordersAndSeparators,
+		Title:    TitleOptionsGreenOnBlack,
 	}
 
 	// StyleColoredBlackOnMagentaWhite renders a Table without any borders or
 	// separators, and with Black text on Magenta background for Header/Footer and
 	// White background for other rows.
 	StyleColoredBlackOnMagentaWhite = Style{
-		Name:    "StyleColoredBlackOnMagentaWhite",
-		Box:     StyleBoxDefault,
-		Color:   ColorOptionsBlackOnMagentaWhite,
-		Format:  FormatOptionsDefault,
-		HTML:    DefaultHTMLOptions,
-		Options: OptionsNoBordersAndSeparators,
-		Title:   TitleOptionsMagentaOnBlack,
+		Name:     "StyleColoredBlackOnMagentaWhite",
+		Box:      StyleBoxDefault,
+		Color:    ColorOptionsBlackOnMagentaWhite,
+		Format:   FormatOptionsDefault,
+		HTML:     DefaultHTMLOptions,
+		Markdown: DefaultMarkdownOptions,
+		Options:  OptionsNoBordersAndSeparators,
+		Title:    TitleOptionsMagentaOnBlack,
 	}
 
 	// StyleColoredBlackOnYellowWhite renders a Table without any borders or
 	// separators, and with Black text on Yellow background for Header/Footer and
 	// White background for other rows.
 	StyleColoredBlackOnYellowWhite = Style{
-		Name:    "StyleColoredBlackOnYellowWhite",
-		Box:     StyleBoxDefault,
-		Color:   ColorOptionsBlackOnYellowWhite,
-		Format:  FormatOptionsDefault,
-		HTML:    DefaultHTMLOptions,
-		Options: OptionsNoBordersAndSeparators,
-		Title:   TitleOptionsYellowOnBlack,
+		Name:     "StyleColoredBlackOnYellowWhite",
+		Box:      StyleBoxDefault,
+		Color:    ColorOptionsBlackOnYellowWhite,
+		Format:   FormatOptionsDefault,
+		HTML:     DefaultHTMLOptions,
+		Markdown: DefaultMarkdownOptions,
+		Options:  OptionsNoBordersAndSeparators,
+		Title:    TitleOptionsYellowOnBlack,
 	}
 
 	// StyleColoredBlackOnRedWhite renders a Table without any borders or
 	// separators, and with Black text on Red background for Header/Footer and
 	// White background for other rows.
 	StyleColoredBlackOnRedWhite = Style{
-		Name:    "StyleColoredBlackOnRedWhite",
-		Box:     StyleBoxDefault,
-		Color:   ColorOptionsBlackOnRedWhite,
-		Format:  FormatOptionsDefault,
-		HTML:    DefaultHTMLOptions,
-		Options: OptionsNoBordersAndSeparators,
-		Title:   TitleOptionsRedOnBlack,
+		Name:     "StyleColoredBlackOnRedWhite",
+		Box:      StyleBoxDefault,
+		Color:    ColorOptionsBlackOnRedWhite,
+		Format:   FormatOptionsDefault,
+		HTML:     DefaultHTMLOptions,
+		Markdown: DefaultMarkdownOptions,
+		Options:  OptionsNoBordersAndSeparators,
+		Title:    TitleOptionsRedOnBlack,
 	}
 
 	// StyleColoredBlueWhiteOnBlack renders a Table without any borders or
 	// separators, and with Header/Footer in Blue text and other rows with
 	// White text, all on Black background.
 	StyleColoredBlueWhiteOnBlack = Style{
-		Name:    "StyleColoredBlueWhiteOnBlack",
-		Box:     StyleBoxDefault,
-		Color:   ColorOptionsBlueWhiteOnBlack,
-		Format:  FormatOptionsDefault,
-		HTML:    DefaultHTMLOptions,
-		Options: OptionsNoBordersAndSeparators,
-		Title:   TitleOptionsBlackOnBlue,
+		Name:     "StyleColoredBlueWhiteOnBlack",
+		Box:      StyleBoxDefault,
+		Color:    ColorOptionsBlueWhiteOnBlack,
+		Format:   FormatOptionsDefault,
+		HTML:     DefaultHTMLOptions,
+		Markdown: DefaultMarkdownOptions,
+		Options:  OptionsNoBordersAndSeparators,
+		Title:    TitleOptionsBlackOnBlue,
 	}
 
 	// StyleColoredCyanWhiteOnBlack renders a Table without any borders or
 	// separators, and with Header/Footer in Cyan text and other rows with
 	// White text, all on Black background.
 	StyleColoredCyanWhiteOnBlack = Style{
-		Name:    "StyleColoredCyanWhiteOnBlack",
-		Box:     StyleBoxDefault,
-		Color:   ColorOptionsCyanWhiteOnBlack,
-		Format:  FormatOptionsDefault,
-		HTML:    DefaultHTMLOptions,
-		Options: OptionsNoBordersAndSeparators,
-		Title:   TitleOptionsBlackOnCyan,
+		Name:     "StyleColoredCyanWhiteOnBlack",
+		Box:      StyleBoxDefault,
+		Color:    ColorOptionsCyanWhiteOnBlack,
+		Format:   FormatOptionsDefault,
+		HTML:     DefaultHTMLOptions,
+		Markdown: DefaultMarkdownOptions,
+		Options:  OptionsNoBordersAndSeparators,
+		Title:    TitleOptionsBlackOnCyan,
 	}
 
 	// StyleColoredGreenWhiteOnBlack renders a Table without any borders or
 	// separators, and with Header/Footer in Green text and other rows with
 	// White text, all on Black background.
 	StyleColoredGreenWhiteOnBlack = Style{
-		Name:    "StyleColoredGreenWhiteOnBlack",
-		Box:     StyleBoxDefault,
-		Color:   ColorOptionsGreenWhiteOnBlack,
-		Format:  FormatOptionsDefault,
-		HTML:    DefaultHTMLOptions,
-		Options: OptionsNoBordersAndSeparators,
-		Title:   TitleOptionsBlackOnGreen,
+		Name:     "StyleColoredGreenWhiteOnBlack",
+		Box:      StyleBoxDefault,
+		Color:    ColorOptionsGreenWhiteOnBlack,
+		Format:   FormatOptionsDefault,
+		HTML:     DefaultHTMLOptions,
+		Markdown: DefaultMarkdownOptions,
+		Options:  OptionsNoBordersAndSeparators,
+		Title:    TitleOptionsBlackOnGreen,
 	}
 
 	// StyleColoredMagentaWhiteOnBlack renders a Table without any borders or
 	// separators, and with Header/Footer in Magenta text and other rows with
 	// White text, all on Black background.
 	StyleColoredMagentaWhiteOnBlack = Style{
-		Name:    "StyleColoredMagentaWhiteOnBlack",
-		Box:     StyleBoxDefault,
-		Color:   ColorOptionsMagentaWhiteOnBlack,
-		Format:  FormatOptionsDefault,
-		HTML:    DefaultHTMLOptions,
-		Options: OptionsNoBordersAndSeparators,
-		Title:   TitleOptionsBlackOnMagenta,
+		Name:     "StyleColoredMagentaWhiteOnBlack",
+		Box:      StyleBoxDefault,
+		Color:    ColorOptionsMagentaWhiteOnBlack,
+		Format:   FormatOptionsDefault,
+		HTML:     DefaultHTMLOptions,
+		Markdown: DefaultMarkdownOptions,
+		Options:  OptionsNoBordersAndSeparators,
+		Title:    TitleOptionsBlackOnMagenta,
 	}
 
 	// StyleColoredRedWhiteOnBlack renders a Table without any borders or
 	// separators, and with Header/Footer in Red text and other rows with
 	// White text, all on Black background.
 	StyleColoredRedWhiteOnBlack = Style{
-		Name:    "StyleColoredRedWhiteOnBlack",
-		Box:     StyleBoxDefault,
-		Color:   ColorOptionsRedWhiteOnBlack,
-		Format:  FormatOptionsDefault,
-		HTML:    DefaultHTMLOptions,
-		Options: OptionsNoBordersAndSeparators,
-		Title:   TitleOptionsBlackOnRed,
+		Name:     "StyleColoredRedWhiteOnBlack",
+		Box:      StyleBoxDefault,
+		Color:    ColorOptionsRedWhiteOnBlack,
+		Format:   FormatOptionsDefault,
+		HTML:     DefaultHTMLOptions,
+		Markdown: DefaultMarkdownOptions,
+		Options:  OptionsNoBordersAndSeparators,
+		Title:    TitleOptionsBlackOnRed,
 	}
 
 	// StyleColoredYellowWhiteOnBlack renders a Table without any borders or
 	// separators, and with Header/Footer in Yellow text and other rows with
 	// White text, all on Black background.
 	StyleColoredYellowWhiteOnBlack = Style{
-		Name:    "StyleColoredYellowWhiteOnBlack",
-		Box:     StyleBoxDefault,
-		Color:   ColorOptionsYellowWhiteOnBlack,
-		Format:  FormatOptionsDefault,
-		HTML:    DefaultHTMLOptions,
-		Options: OptionsNoBordersAndSeparators,
-		Title:   TitleOptionsBlackOnYellow,
+		Name:     "StyleColoredYellowWhiteOnBlack",
+		Box:      StyleBoxDefault,
+		Color:    ColorOptionsYellowWhiteOnBlack,
+		Format:   FormatOptionsDefault,
+		HTML:     DefaultHTMLOptions,
+		Markdown: DefaultMarkdownOptions,
+		Options:  OptionsNoBordersAndSeparators,
+		Title:    TitleOptionsBlackOnYellow,
 	}
 
 	// StyleDouble renders a Table like below:
@@ -250,13 +268,14 @@ var (
 	//  ║     ║            ║ TOTAL     ║  10000 ║                             ║
 	//  ╚═════╩════════════╩═══════════╩════════╩═════════════════════════════╝
 	StyleDouble = Style{
-		Name:    "StyleDouble",
-		Box:     StyleBoxDouble,
-		Color:   ColorOptionsDefault,
-		Format:  FormatOptionsDefault,
-		HTML:    DefaultHTMLOptions,
-		Options: OptionsDefault,
-		Title:   TitleOptionsDefault,
+		Name:     "StyleDouble",
+		Box:      StyleBoxDouble,
+		Color:    ColorOptionsDefault,
+		Format:   FormatOptionsDefault,
+		HTML:     DefaultHTMLOptions,
+		Markdown: DefaultMarkdownOptions,
+		Options:  OptionsDefault,
+		Title:    TitleOptionsDefault,
 	}
 
 	// StyleLight renders a Table like below:
@@ -270,13 +289,14 @@ var (
 	//  │     │            │ TOTAL     │  10000 │                             │
 	//  └─────┴────────────┴───────────┴────────┴─────────────────────────────┘
 	StyleLight = Style{
-		Name:    "StyleLight",
-		Box:     StyleBoxLight,
-		Color:   ColorOptionsDefault,
-		Format:  FormatOptionsDefault,
-		HTML:    DefaultHTMLOptions,
-		Options: OptionsDefault,
-		Title:   TitleOptionsDefault,
+		Name:     "StyleLight",
+		Box:      StyleBoxLight,
+		Color:    ColorOptionsDefault,
+		Format:   FormatOptionsDefault,
+		HTML:     DefaultHTMLOptions,
+		Markdown: DefaultMarkdownOptions,
+		Options:  OptionsDefault,
+		Title:    TitleOptionsDefault,
 	}
 
 	// StyleRounded renders a Table like below:
@@ -290,13 +310,14 @@ var (
 	//  │     │            │ TOTAL     │  10000 │                             │
 	//  ╰─────┴────────────┴───────────┴────────┴─────────────────────────────╯
 	StyleRounded = Style{
-		Name:    "StyleRounded",
-		Box:     StyleBoxRounded,
-		Color:   ColorOptionsDefault,
-		Format:  FormatOptionsDefault,
-		HTML:    DefaultHTMLOptions,
-		Options: OptionsDefault,
-		Title:   TitleOptionsDefault,
+		Name:     "StyleRounded",
+		Box:      StyleBoxRounded,
+		Color:    ColorOptionsDefault,
+		Format:   FormatOptionsDefault,
+		HTML:     DefaultHTMLOptions,
+		Markdown: DefaultMarkdownOptions,
+		Options:  OptionsDefault,
+		Title:    TitleOptionsDefault,
 	}
 
 	// styleTest renders a Table like below:
@@ -310,13 +331,14 @@ var (
 	//  [<   >|<          >|<TOTAL    >|< 10000>|<                           >]
 	//  \-----v------------v-----------v--------v-----------------------------/
 	styleTest = Style{
-		Name:    "styleTest",
-		Box:     styleBoxTest,
-		Color:   ColorOptionsDefault,
-		Format:  FormatOptionsDefault,
-		HTML:    DefaultHTMLOptions,
-		Options: OptionsDefault,
-		Title:   TitleOptionsDefault,
+		Name:     "styleTest",
+		Box:      styleBoxTest,
+		Color:    ColorOptionsDefault,
+		Format:   FormatOptionsDefault,
+		HTML:     DefaultHTMLOptions,
+		Markdown: DefaultMarkdownOptions,
+		Options:  OptionsDefault,
+		Title:    TitleOptionsDefault,
 	}
 )
 
@@ -683,9 +705,11 @@ var (
 
 // HTMLOptions defines the global options to control HTML rendering.
 type HTMLOptions struct {
+	ConvertANSI bool   // convert ANSI escape sequences (colors) in the text into HTML (see text.ANSIToHTML)?
 	CSSClass    string // CSS class to set on the overall <table> tag
 	EmptyColumn string // string to replace "" columns with (entire content being "")
 	EscapeText  bool   // escape text into HTML-safe content?
+	InlineCSS   bool   // inline the styling (see Style.CSS) into the tags instead of using CSS classes?
 	Newline     string // string to replace "\n" characters with
 }
 
@@ -699,6 +723,22 @@ var (
 	}
 )
 
+// MarkdownOptions defines the global options to control Markdown rendering.
+type MarkdownOptions struct {
+	AlignFromHeader bool   // use ColumnConfig.AlignHeader (if set) instead of ColumnConfig.Align for the alignment markers?
+	BoldFooter      bool   // render the footer rows in bold to tell them apart from the rows?
+	EscapeText      bool   // escape backslashes, backticks and HTML in the text (pipes are always escaped)?
+	Newline         string // string to replace "\n" characters with; "<br/>" if empty
+	PadColumns      bool   // pad (and align) the text in the columns to the same width for readability in raw form?
+}
+
+var (
+	// DefaultMarkdownOptions defines sensible Markdown rendering defaults.
+	DefaultMarkdownOptions = MarkdownOptions{
+		Newline: "<br/>",
+	}
+)
+
 // Options defines the global options that determine how the Table is
 // rendered.
 type Options struct {
@@ -0,0 +1,183 @@
+package table
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/text"
+)
+
+// cssRule is a CSS rule made of a selector and its declarations.
+type cssRule struct {
+	selector     string
+	declarations []string
+}
+
+// CSS returns a stylesheet for the HTML rendering (see RenderHTML) of a Table
+// using this Style. It styles the borders and separators (derived from Box and
+// Options), the padding, the alignment, the colors of the header/rows/footer
+// (including RowAlternate for the even-numbered rows), and includes all the
+// CSS classes used for colors in the HTML output. All the rules are scoped
+// under the CSS class in HTML.CSSClass. For ex., for StyleLight:
+//  table.go-pretty-table { border-collapse: collapse; border: 1px solid; }
+//  .go-pretty-table th, .go-pretty-table td { padding: 0 1ch; }
+//  .go-pretty-table th + th, .go-pretty-table td + td { border-left: 1px solid; }
+//  .go-pretty-table thead { border-bottom: 1px solid; }
+//  .go-pretty-table tfoot { border-top: 1px solid; }
+//  ...
+//
+// Set HTML.InlineCSS to get the same styling inlined into each HTML tag for
+// cases where stylesheets cannot be used, like in HTML e-mails.
+func (s Style) CSS() string {
+	scope := "." + s.HTML.CSSClass
+	if s.HTML.CSSClass == "" {
+		scope = "." + DefaultHTMLCSSClass
+	}
+	scoped := func(selectors ...string) string {
+		for idx := range selectors {
+			selectors[idx] = scope + " " + selectors[idx]
+		}
+		return strings.Join(selectors, ", ")
+	}
+
+	rules := []cssRule{
+		{selector: "table" + scope, declarations: s.cssTable()},
+		{selector: scoped("th", "td"), declarations: s.cssPadding()},
+	}
+	if border := s.cssBorderVertical(); border != "" && s.Options.SeparateColumns {
+		rules = append(rules, cssRule{scoped("th + th", "td + td"), []string{"border-left: " + border}})
+	}
+	if border := s.cssBorderHorizontal(); border != "" {
+		if s.Options.SeparateHeader {
+			rules = append(rules, cssRule{scoped("thead"), []string{"border-bottom: " + border}})
+		}
+		if s.Options.SeparateRows {
+			rules = append(rules, cssRule{scoped("tbody tr + tr"), []string{"border-top: " + border}})
+		}
+		if s.Options.SeparateFooter {
+			rules = append(rules, cssRule{scoped("tfoot"), []string{"border-top: " + border}})
+		}
+	}
+	rules = append(rules,
+		cssRule{scoped("thead tr"), cssDeclarations(s.Color.Header.CSSStyle())},
+		cssRule{scoped("tbody tr"), cssDeclarations(s.Color.Row.CSSStyle())},
+		cssRule{scoped("tbody tr:nth-child(even)"), cssDeclarations(s.Color.RowAlternate.CSSStyle())},
+		cssRule{scoped("tfoot tr"), cssDeclarations(s.Color.Footer.CSSStyle())},
+	)
+	for _, align := range []string{"left", "center", "justify", "right"} {
+		rules = append(rules, cssRule{scoped("[align=\"" + align + "\"]"), []string{"text-align: " + align}})
+	}
+	for _, vAlign := range []string{"top", "middle", "bottom"} {
+		rules = append(rules, cssRule{scoped("[valign=\"" + vAlign + "\"]"), []string{"vertical-align: " + vAlign}})
+	}
+
+	var out strings.Builder
+	for _, rule := range rules {
+		if len(rule.declarations) == 0 {
+			continue
+		}
+		out.WriteString(rule.selector)
+		out.WriteString(" { ")
+		out.WriteString(strings.Join(rule.declarations, "; "))
+		out.WriteString("; }\n")
+	}
+	out.WriteString(text.GenerateCSSScoped(scope))
+	return out.String()
+}
+
+// cssCell returns the inline CSS declarations for a cell (th/td) in the given
+// column of the row described by the hint; used when HTML.InlineCSS is set.
+func (s Style) cssCell(colIdx int, hint renderHint) []string {
+	declarations := s.cssPadding()
+	if border := s.cssBorderVertical(); border != "" && s.Options.SeparateColumns && colIdx > 0 {
+		declarations = append(declarations, "border-left: "+border)
+	}
+	if border := s.cssBorderHorizontal(); border != "" {
+		if hint.isHeaderRow && hint.isLastRow && s.Options.SeparateHeader {
+			declarations = append(declarations, "border-bottom: "+border)
+		} else if hint.isFooterRow && hint.isFirstRow && s.Options.SeparateFooter {
+			declarations = append(declarations, "border-top: "+border)
+		} else if hint.isRegularRow() && !hint.isFirstRow && s.Options.SeparateRows {
+			declarations = append(declarations, "border-top: "+border)
+		}
+	}
+	return declarations
+}
+
+// cssRow returns the inline CSS declarations for a row (tr) described by the
+// hint; used when HTML.InlineCSS is set.
+func (s Style) cssRow(hint renderHint) []string {
+	colors := s.Color.Row
+	if hint.isHeaderRow {
+		colors = s.Color.Header
+	} else if hint.isFooterRow {
+		colors = s.Color.Footer
+	} else if hint.rowNumber%2 == 0 && len(s.Color.RowAlternate) > 0 {
+		colors = s.Color.RowAlternate
+	}
+	return cssDeclarations(colors.CSSStyle())
+}
+
+// cssTable returns the CSS declarations for the table tag.
+func (s Style) cssTable() []string {
+	declarations := []string{"border-collapse: collapse"}
+	if border := cssBorder(s.Box.Left); border != "" && s.Options.DrawBorder {
+		declarations = append(declarations, "border: "+border)
+	}
+	return declarations
+}
+
+// cssBorderHorizontal returns the CSS border for the horizontal separators.
+func (s Style) cssBorderHorizontal() string {
+	return cssBorder(s.Box.MiddleHorizontal)
+}
+
+// cssBorderVertical returns the CSS border for the vertical separators.
+func (s Style) cssBorderVertical() string {
+	return cssBorder(s.Box.MiddleVertical)
+}
+
+// cssPadding returns the CSS declarations for the padding of the cells, with
+// each character of the padding being as wide as a "0".
+func (s Style) cssPadding() []string {
+	left := text.RuneCount(s.Box.PaddingLeft)
+	right := text.RuneCount(s.Box.PaddingRight)
+	if left == 0 && right == 0 {
+		return nil
+	}
+	if left == right {
+		return []string{"padding: 0 " + cssLength(left)}
+	}
+	return []string{"padding: 0 " + cssLength(right) + " 0 " + cssLength(left)}
+}
+
+// cssBorder returns the CSS border equivalent to the box-drawing character(s)
+// used to draw a border/separator, or an empty string if none is drawn.
+func cssBorder(box string) string {
+	switch {
+	case strings.TrimSpace(box) == "":
+		return ""
+	case strings.ContainsAny(box, "═║╔╗╚╝╠╣╦╩╬"):
+		return "3px double"
+	case strings.ContainsAny(box, "━┃┏┓┗┛┣┫┳┻╋"):
+		return "2px solid"
+	}
+	return "1px solid"
+}
+
+// cssDeclarations splits the inline style (ex.: "color: #cd0000; font-weight:
+// bold") into its declarations.
+func cssDeclarations(style string) []string {
+	if style == "" {
+		return nil
+	}
+	return strings.Split(style, "; ")
+}
+
+// cssLength returns the CSS length for the given number of characters.
+func cssLength(numChars int) string {
+	if numChars == 0 {
+		return "0"
+	}
+	return strconv.Itoa(numChars) + "ch"
+}
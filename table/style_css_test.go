@@ -0,0 +1,174 @@
+package table
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStyle_CSS(t *testing.T) {
+	expectedCSS := []string{
+		`table.go-pretty-table { border-collapse: collapse; border: 1px solid; }`,
+		`.go-pretty-table th, .go-pretty-table td { padding: 0 1ch; }`,
+		`.go-pretty-table th + th, .go-pretty-table td + td { border-left: 1px solid; }`,
+		`.go-pretty-table thead { border-bottom: 1px solid; }`,
+		`.go-pretty-table tfoot { border-top: 1px solid; }`,
+		`.go-pretty-table [align="left"] { text-align: left; }`,
+		`.go-pretty-table [align="center"] { text-align: center; }`,
+		`.go-pretty-table [align="justify"] { text-align: justify; }`,
+		`.go-pretty-table [align="right"] { text-align: right; }`,
+		`.go-pretty-table [valign="top"] { vertical-align: top; }`,
+		`.go-pretty-table [valign="middle"] { vertical-align: middle; }`,
+		`.go-pretty-table [valign="bottom"] { vertical-align: bottom; }`,
+	}
+	css := StyleLight.CSS()
+	assert.Equal(t, strings.Join(expectedCSS, "\n")+"\n"+text.GenerateCSSScoped(".go-pretty-table"), css)
+}
+
+func TestStyle_CSS_Borders(t *testing.T) {
+	t.Run("bold", func(t *testing.T) {
+		css := StyleBold.CSS()
+		assert.Contains(t, css, "table.go-pretty-table { border-collapse: collapse; border: 2px solid; }\n")
+		assert.Contains(t, css, ".go-pretty-table thead { border-bottom: 2px solid; }\n")
+	})
+
+	t.Run("double", func(t *testing.T) {
+		css := StyleDouble.CSS()
+		assert.Contains(t, css, "table.go-pretty-table { border-collapse: collapse; border: 3px double; }\n")
+		assert.Contains(t, css, ".go-pretty-table th + th, .go-pretty-table td + td { border-left: 3px double; }\n")
+	})
+
+	t.Run("options", func(t *testing.T) {
+		style := StyleLight
+		style.Options = Options{SeparateRows: true}
+		css := style.CSS()
+		assert.Contains(t, css, "table.go-pretty-table { border-collapse: collapse; }\n")
+		assert.Contains(t, css, ".go-pretty-table tbody tr + tr { border-top: 1px solid; }\n")
+		assert.NotContains(t, css, "border-left")
+		assert.NotContains(t, css, "thead {")
+		assert.NotContains(t, css, "tfoot {")
+	})
+
+	t.Run("padding", func(t *testing.T) {
+		style := StyleLight
+		style.Box.PaddingLeft = ""
+		style.Box.PaddingRight = "  "
+		assert.Contains(t, style.CSS(), ".go-pretty-table th, .go-pretty-table td { padding: 0 2ch 0 0; }\n")
+
+		style.Box.PaddingRight = ""
+		assert.NotContains(t, style.CSS(), "padding")
+	})
+}
+
+func TestStyle_CSS_Colors(t *testing.T) {
+	style := StyleColoredBright
+	style.HTML.CSSClass = "report"
+	css := style.CSS()
+
+	assert.Contains(t, css, ".report thead tr { background-color: #00ffff; color: #000000; }\n")
+	assert.Contains(t, css, ".report tbody tr { background-color: #ffffff; color: #000000; }\n")
+	assert.Contains(t, css, ".report tbody tr:nth-child(even) { background-color: #e5e5e5; color: #000000; }\n")
+	assert.Contains(t, css, ".report tfoot tr { background-color: #00cdcd; color: #000000; }\n")
+	assert.Contains(t, css, ".report .fg-hi-red { color: #ff0000; }\n")
+	assert.NotContains(t, css, "go-pretty-table")
+}
+
+func TestTable_RenderHTML_InlineCSS(t *testing.T) {
+	tw := NewWriter()
+	tw.AppendHeader(testHeader)
+	tw.AppendRows(testRows)
+	tw.AppendFooter(testFooter)
+	tw.SetAutoIndex(true)
+	tw.SetColumnConfigs([]ColumnConfig{
+		{Name: "Salary", Colors: text.Colors{text.FgHiRed}},
+	})
+	tw.SetStyle(StyleColoredBright)
+	tw.SetTitle(testTitle1)
+	tw.Style().HTML.InlineCSS = true
+	tw.Style().Options.SeparateRows = true
+	tw.Style().Title.Colors = text.Colors{text.Bold}
+
+	expectedOut := `<table class="go-pretty-table" style="border-collapse: collapse">
+  <caption class="title" style="font-weight: bold">Game of Thrones</caption>
+  <thead>
+  <tr style="background-color: #00ffff; color: #000000">
+    <th style="padding: 0 1ch">&nbsp;</th>
+    <th align="right" style="padding: 0 1ch">#</th>
+    <th style="padding: 0 1ch">First Name</th>
+    <th style="padding: 0 1ch">Last Name</th>
+    <th align="right" style="padding: 0 1ch">Salary</th>
+    <th style="padding: 0 1ch">&nbsp;</th>
+  </tr>
+  </thead>
+  <tbody>
+  <tr style="background-color: #ffffff; color: #000000">
+    <td align="right" style="padding: 0 1ch">1</td>
+    <td align="right" style="padding: 0 1ch">1</td>
+    <td style="padding: 0 1ch">Arya</td>
+    <td style="padding: 0 1ch">Stark</td>
+    <td align="right" style="padding: 0 1ch; color: #ff0000">3000</td>
+    <td style="padding: 0 1ch">&nbsp;</td>
+  </tr>
+  <tr style="background-color: #e5e5e5; color: #000000">
+    <td align="right" style="padding: 0 1ch; border-top: 1px solid">2</td>
+    <td align="right" style="padding: 0 1ch; border-top: 1px solid">20</td>
+    <td style="padding: 0 1ch; border-top: 1px solid">Jon</td>
+    <td style="padding: 0 1ch; border-top: 1px solid">Snow</td>
+    <td align="right" style="padding: 0 1ch; border-top: 1px solid; color: #ff0000">2000</td>
+    <td style="padding: 0 1ch; border-top: 1px solid">You know nothing, Jon Snow!</td>
+  </tr>
+  <tr style="background-color: #ffffff; color: #000000">
+    <td align="right" style="padding: 0 1ch; border-top: 1px solid">3</td>
+    <td align="right" style="padding: 0 1ch; border-top: 1px solid">300</td>
+    <td style="padding: 0 1ch; border-top: 1px solid">Tyrion</td>
+    <td style="padding: 0 1ch; border-top: 1px solid">Lannister</td>
+    <td align="right" style="padding: 0 1ch; border-top: 1px solid; color: #ff0000">5000</td>
+    <td style="padding: 0 1ch; border-top: 1px solid">&nbsp;</td>
+  </tr>
+  </tbody>
+  <tfoot>
+  <tr style="background-color: #00cdcd; color: #000000">
+    <td style="padding: 0 1ch">&nbsp;</td>
+    <td align="right" style="padding: 0 1ch">&nbsp;</td>
+    <td style="padding: 0 1ch">&nbsp;</td>
+    <td style="padding: 0 1ch">Total</td>
+    <td align="right" style="padding: 0 1ch">10000</td>
+    <td style="padding: 0 1ch">&nbsp;</td>
+  </tr>
+  </tfoot>
+</table>`
+	assert.Equal(t, expectedOut, tw.RenderHTML())
+}
+
+func TestTable_RenderHTML_InlineCSS_Borders(t *testing.T) {
+	tw := NewWriter()
+	tw.AppendHeader(Row{"A", "B"})
+	tw.AppendRow(Row{1, 2})
+	tw.AppendFooter(Row{"", 3})
+	tw.SetStyle(StyleDouble)
+	tw.Style().HTML.InlineCSS = true
+
+	expectedOut := `<table class="go-pretty-table" style="border-collapse: collapse; border: 3px double">
+  <thead>
+  <tr>
+    <th align="right" style="padding: 0 1ch; border-bottom: 3px double">A</th>
+    <th align="right" style="padding: 0 1ch; border-left: 3px double; border-bottom: 3px double">B</th>
+  </tr>
+  </thead>
+  <tbody>
+  <tr>
+    <td align="right" style="padding: 0 1ch">1</td>
+    <td align="right" style="padding: 0 1ch; border-left: 3px double">2</td>
+  </tr>
+  </tbody>
+  <tfoot>
+  <tr>
+    <td align="right" style="padding: 0 1ch; border-top: 3px double">&nbsp;</td>
+    <td align="right" style="padding: 0 1ch; border-left: 3px double; border-top: 3px double">3</td>
+  </tr>
+  </tfoot>
+</table>`
+	assert.Equal(t, expectedOut, tw.RenderHTML())
+}
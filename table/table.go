@@ -15,6 +15,13 @@ type Row []interface{}
 // text.Colors{} to use on the entire row
 type RowPainter func(row Row) text.Colors
 
+// cellChart contains the data bar/sparkline rendered for a single cell in both
+// the text and the HTML forms.
+type cellChart struct {
+	html string
+	str  string
+}
+
 // rowStr defines a single row in the Table comprised of just string objects.
 type rowStr []string
 
@@ -34,6 +41,9 @@ type Table struct {
 	// caption stores the text to be rendered just below the table; and doesn't
 	// get used when rendered as a CSV
 	caption string
+	// colorProfile stores the text.ColorProfile to render with; the global one
+	// is used if not set
+	colorProfile *text.ColorProfile
 	// columnIsNonNumeric stores if a column contains non-numbers in all rows
 	columnIsNonNumeric []bool
 	// columnConfigs stores the custom-configuration for 1 or more columns
@@ -41,10 +51,16 @@ type Table struct {
 	// columnConfigMap stores the custom-configuration by column
 	// number and is generated before rendering
 	columnConfigMap map[int]ColumnConfig
+	// hiddenColumns stores the indices (in the raw rows) of the columns hidden
+	// using ColumnConfig.Hidden or SuppressEmptyColumns
+	hiddenColumns map[int]bool
 	// htmlCSSClass stores the HTML CSS Class to use on the <table> node
 	htmlCSSClass string
 	// indexColumn stores the number of the column considered as the "index"
 	indexColumn int
+	// markdownColumnWidths stores the width of each column (including the
+	// auto-index column) when padding the columns in Markdown mode
+	markdownColumnWidths []int
 	// maxColumnLengths stores the length of the longest line in each column
 	maxColumnLengths []int
 	// maxRowLength stores the length of the longest row
@@ -60,11 +76,21 @@ type Table struct {
 	// again (to denote a page break) - useful when you are dealing with really
 	// long tables
 	pageSize int
+	// renderCharts is set while rendering in the text/HTML forms to render the
+	// data bars and sparklines (see ColumnConfig.DataBar/Sparkline) instead of
+	// the values; the other forms (CSV, Markdown, etc.) get the values as is
+	renderCharts bool
 	// rows stores the rows that make up the body (in string form)
 	rows []rowStr
 	// rowsColors stores the text.Colors over-rides for each row as defined by
 	// rowPainter
 	rowsColors []text.Colors
+	// rowsColorsByRules stores the text.Colors for each cell in each row as
+	// determined by ColumnConfig.ColorRules
+	rowsColorsByRules [][]text.Colors
+	// rowsCharts stores the rendered data bars/sparklines for each cell in
+	// each row as determined by ColumnConfig.DataBar/Sparkline
+	rowsCharts [][]cellChart
 	// rowsConfigs stores RowConfig for each row
 	rowsConfigMap map[int]RowConfig
 	// rowsRaw stores the rows that make up the body
@@ -92,6 +118,9 @@ type Table struct {
 	separators map[int]bool
 	// sortBy stores a map of Column
 	sortBy []SortBy
+	// sortedRowIndices stores the indices (in rowsRaw) of the rows in the
+	// order they get rendered in; nil if the rows are not sorted
+	sortedRowIndices []int
 	// style contains all the strings used to draw the table, and more
 	style *Style
 	// suppressEmptyColumns hides columns which have no content on all regular
@@ -214,6 +243,16 @@ func (t *Table) SetCaption(format string, a ...interface{}) {
 	t.caption = fmt.Sprintf(format, a...)
 }
 
+// SetColorProfile sets the text.ColorProfile to render with instead of the
+// global one (see text.SetColorProfile); colors not supported by the profile
+// get downgraded to the nearest supported color, and all escape sequences get
+// stripped for text.ColorProfileNone. This is useful when rendering to an
+// output that is not os.Stdout; for ex.:
+//  t.SetColorProfile(text.DetectColorProfile(logFile))
+func (t *Table) SetColorProfile(profile text.ColorProfile) {
+	t.colorProfile = &profile
+}
+
 // SetColumnConfigs sets the configs for each Column.
 func (t *Table) SetColumnConfigs(configs []ColumnConfig) {
 	t.columnConfigs = configs
@@ -366,6 +405,13 @@ func (t *Table) getBorderColors(hint renderHint) text.Colors {
 	return t.style.Color.Header
 }
 
+func (t *Table) getColorProfile() text.ColorProfile {
+	if t.colorProfile != nil {
+		return *t.colorProfile
+	}
+	return text.GetColorProfile()
+}
+
 func (t *Table) getColumnColors(colIdx int, hint renderHint) text.Colors {
 	if t.rowPainter != nil && hint.isRegularRow() && !t.isIndexColumn(colIdx, hint) {
 		colors := t.rowsColors[hint.rowNumber-1]
@@ -386,6 +432,24 @@ func (t *Table) getColumnColors(colIdx int, hint renderHint) text.Colors {
 	return nil
 }
 
+func (t *Table) getColumnColorsByRules(colIdx int, hint renderHint) text.Colors {
+	if hint.isRegularRow() && !hint.isSeparatorRow && hint.rowNumber > 0 && hint.rowNumber <= len(t.rowsColorsByRules) {
+		if colors := t.rowsColorsByRules[hint.rowNumber-1]; colIdx < len(colors) {
+			return colors[colIdx]
+		}
+	}
+	return nil
+}
+
+func (t *Table) getColumnHTML(colIdx int, hint renderHint) string {
+	if hint.isRegularRow() && hint.rowNumber > 0 && hint.rowNumber <= len(t.rowsCharts) {
+		if charts := t.rowsCharts[hint.rowNumber-1]; colIdx < len(charts) {
+			return charts[colIdx].html
+		}
+	}
+	return ""
+}
+
 func (t *Table) getColumnSeparator(row rowStr, colIdx int, hint renderHint) string {
 	separator := t.style.Box.MiddleVertical
 	if hint.isSeparatorRow {
@@ -473,6 +537,25 @@ func (t *Table) getColumnWidthMax(colIdx int) int {
 	return 0
 }
 
+// getColumnWidthEnforced returns the width of the column after enforcing the
+// given max. width on all the cells. Some WidthEnforcer functions (for ex.
+// WidthEnforcerKeepURLs) may refuse to break the text and return lines longer
+// than the max. width; and the column is expanded to accommodate them.
+func (t *Table) getColumnWidthEnforced(colIdx int, maxWidth int) int {
+	widthEnforcer := t.columnConfigMap[colIdx].getWidthMaxEnforcer()
+	width := maxWidth
+	for _, rows := range [][]rowStr{t.rowsHeader, t.rows, t.rowsFooter} {
+		for _, row := range rows {
+			if colIdx < len(row) {
+				if colWidth := text.LongestLineLen(widthEnforcer(row[colIdx], maxWidth)); colWidth > width {
+					width = colWidth
+				}
+			}
+		}
+	}
+	return width
+}
+
 func (t *Table) getColumnWidthMin(colIdx int) int {
 	if cfg, ok := t.columnConfigMap[colIdx]; ok {
 		return cfg.WidthMin
@@ -525,6 +608,19 @@ func (t *Table) getRowConfig(hint renderHint) RowConfig {
 	}
 }
 
+func (t *Table) getRowColors(colIdx int, hint renderHint) text.Colors {
+	if hint.isHeaderRow {
+		return t.style.Color.Header
+	} else if hint.isFooterRow {
+		return t.style.Color.Footer
+	} else if colIdx == t.indexColumn-1 && t.style.Color.IndexColumn != nil {
+		return t.style.Color.IndexColumn
+	} else if hint.rowNumber%2 == 0 && t.style.Color.RowAlternate != nil {
+		return t.style.Color.RowAlternate
+	}
+	return t.style.Color.Row
+}
+
 func (t *Table) getSeparatorColors(hint renderHint) text.Colors {
 	if hint.isHeaderRow {
 		return t.style.Color.Header
@@ -572,6 +668,94 @@ func (t *Table) initForRender() {
 	t.numLinesRendered = 0
 }
 
+func (t *Table) initForRenderCharts() {
+	for colIdx, colCfg := range t.columnConfigMap {
+		if (colCfg.DataBar == nil && colCfg.Sparkline == nil) || colIdx >= t.numColumns {
+			continue
+		}
+		if t.rowsCharts == nil {
+			t.rowsCharts = make([][]cellChart, len(t.rowsRaw))
+		}
+
+		// values are right-aligned next to the bars to keep the bars aligned
+		stats, valLenMax := newColumnStats(t.rowsRaw, colIdx), 0
+		for rowIdx, row := range t.rowsRaw {
+			if colIdx < len(row) {
+				if valLen := text.RuneCount(t.rows[rowIdx][colIdx]); valLen > valLenMax {
+					valLenMax = valLen
+				}
+			}
+		}
+
+		for rowIdx, row := range t.rowsRaw {
+			if colIdx >= len(row) {
+				continue
+			}
+
+			var colStr, colHTML string
+			if colCfg.DataBar != nil {
+				if number, ok := toFloat64(row[colIdx]); ok {
+					fraction := getDataBarFraction(number, stats)
+					colStr = colCfg.DataBar.Colors.SprintProfile(t.getColorProfile(), text.Bar(fraction, colCfg.DataBar.getWidth()))
+					colHTML = htmlDataBar(fraction, colCfg.DataBar)
+					if valStr := t.rows[rowIdx][colIdx]; !colCfg.DataBar.HideValue {
+						colStr += " " + strings.Repeat(" ", valLenMax-text.RuneCount(valStr)) + valStr
+						colHTML += " " + t.htmlEscape(valStr)
+					}
+				}
+			} else if values, ok := toFloat64Slice(row[colIdx]); ok {
+				colStr = colCfg.Sparkline.Colors.SprintProfile(t.getColorProfile(), text.Sparkline(values))
+				colHTML = htmlSparkline(values, colCfg.Sparkline)
+			}
+
+			if colHTML != "" {
+				if t.rowsCharts[rowIdx] == nil {
+					t.rowsCharts[rowIdx] = make([]cellChart, t.numColumns)
+				}
+				t.rowsCharts[rowIdx][colIdx] = cellChart{html: colHTML, str: colStr}
+			}
+		}
+	}
+}
+
+func (t *Table) initForRenderChartsInRows() {
+	for rowIdx, charts := range t.rowsCharts {
+		for colIdx, chart := range charts {
+			if chart.html != "" {
+				t.rows[rowIdx][colIdx] = chart.str
+			}
+		}
+	}
+}
+
+func (t *Table) initForRenderColorRules() {
+	for colIdx, colCfg := range t.columnConfigMap {
+		if len(colCfg.ColorRules) == 0 || colIdx >= t.numColumns {
+			continue
+		}
+		if t.rowsColorsByRules == nil {
+			t.rowsColorsByRules = make([][]text.Colors, len(t.rowsRaw))
+		}
+
+		stats := newColumnStats(t.rowsRaw, colIdx)
+		for rowIdx, row := range t.rowsRaw {
+			if colIdx >= len(row) {
+				continue
+			}
+			var colors text.Colors
+			for _, colorRule := range colCfg.ColorRules {
+				colors = append(colors, colorRule(row[colIdx], stats)...)
+			}
+			if len(colors) > 0 {
+				if t.rowsColorsByRules[rowIdx] == nil {
+					t.rowsColorsByRules[rowIdx] = make([]text.Colors, t.numColumns)
+				}
+				t.rowsColorsByRules[rowIdx][colIdx] = colors
+			}
+		}
+	}
+}
+
 func (t *Table) initForRenderColumnConfigs() {
 	findColumnNumber := func(row Row, colName string) int {
 		for colIdx, col := range row {
@@ -621,7 +805,7 @@ func (t *Table) initForRenderColumnLengths() {
 	for colIdx := range t.maxColumnLengths {
 		maxWidth := t.getColumnWidthMax(colIdx)
 		if maxWidth > 0 && t.maxColumnLengths[colIdx] > maxWidth {
-			t.maxColumnLengths[colIdx] = maxWidth
+			t.maxColumnLengths[colIdx] = t.getColumnWidthEnforced(colIdx, maxWidth)
 		}
 		minWidth := t.getColumnWidthMin(colIdx)
 		if minWidth > 0 && t.maxColumnLengths[colIdx] < minWidth {
@@ -645,6 +829,12 @@ func (t *Table) initForRenderHideColumns() {
 
 	colIdxMap := make(map[int]int)
 	numColumns := 0
+	t.hiddenColumns = make(map[int]bool)
+	for colIdx, cc := range t.columnConfigMap {
+		if cc.Hidden {
+			t.hiddenColumns[colIdx] = true
+		}
+	}
 	_hideColumns := func(rows []rowStr) []rowStr {
 		var rsp []rowStr
 		for _, row := range rows {
@@ -681,6 +871,32 @@ func (t *Table) initForRenderHideColumns() {
 	}
 	t.columnIsNonNumeric = columnIsNonNumeric
 
+	// re-create rowsColorsByRules with new column indices
+	for rowIdx, colors := range t.rowsColorsByRules {
+		if colors != nil {
+			colorsNew := make([]text.Colors, t.numColumns)
+			for oldColIdx, color := range colors {
+				if newColIdx, ok := colIdxMap[oldColIdx]; ok {
+					colorsNew[newColIdx] = color
+				}
+			}
+			t.rowsColorsByRules[rowIdx] = colorsNew
+		}
+	}
+
+	// re-create rowsCharts with new column indices
+	for rowIdx, charts := range t.rowsCharts {
+		if charts != nil {
+			chartsNew := make([]cellChart, t.numColumns)
+			for oldColIdx, chart := range charts {
+				if newColIdx, ok := colIdxMap[oldColIdx]; ok {
+					chartsNew[newColIdx] = chart
+				}
+			}
+			t.rowsCharts[rowIdx] = chartsNew
+		}
+	}
+
 	// re-create columnConfigMap with new column indices
 	columnConfigMap := make(map[int]ColumnConfig)
 	for oldColIdx, cc := range t.columnConfigMap {
@@ -705,9 +921,20 @@ func (t *Table) initForRenderRows() {
 	t.rowsFooter = t.initForRenderRowsStringify(t.rowsFooterRaw, renderHint{isFooterRow: true})
 	t.rowsHeader = t.initForRenderRowsStringify(t.rowsHeaderRaw, renderHint{isHeaderRow: true})
 
+	// evaluate the color rules on the raw values
+	t.initForRenderColorRules()
+
+	// render data bars and sparklines on the raw values
+	if t.renderCharts {
+		t.initForRenderCharts()
+	}
+
 	// sort the rows as requested
 	t.initForRenderSortRows()
 
+	// replace the values with the data bars and sparklines
+	t.initForRenderChartsInRows()
+
 	// suppress columns without any content
 	t.initForRenderSuppressColumns()
 
@@ -757,6 +984,7 @@ func (t *Table) initForRenderSortRows() {
 
 	// sort the rows
 	sortedRowIndices := t.getSortedRowIndices()
+	t.sortedRowIndices = sortedRowIndices
 	sortedRows := make([]rowStr, len(t.rows))
 	for idx := range t.rows {
 		sortedRows[idx] = t.rows[sortedRowIndices[idx]]
@@ -771,6 +999,24 @@ func (t *Table) initForRenderSortRows() {
 		}
 		t.rowsColors = sortedRowsColors
 	}
+
+	// sort the rowsColorsByRules
+	if len(t.rowsColorsByRules) > 0 {
+		sortedRowsColorsByRules := make([][]text.Colors, len(t.rows))
+		for idx := range t.rows {
+			sortedRowsColorsByRules[idx] = t.rowsColorsByRules[sortedRowIndices[idx]]
+		}
+		t.rowsColorsByRules = sortedRowsColorsByRules
+	}
+
+	// sort the rowsCharts
+	if len(t.rowsCharts) > 0 {
+		sortedRowsCharts := make([][]cellChart, len(t.rows))
+		for idx := range t.rows {
+			sortedRowsCharts[idx] = t.rowsCharts[sortedRowIndices[idx]]
+		}
+		t.rowsCharts = sortedRowsCharts
+	}
 }
 
 func (t *Table) initForRenderSuppressColumns() {
@@ -799,7 +1045,7 @@ func (t *Table) isIndexColumn(colIdx int, hint renderHint) bool {
 }
 
 func (t *Table) render(out *strings.Builder) string {
-	outStr := out.String()
+	outStr := t.getColorProfile().Convert(out.String())
 	if t.outputMirror != nil && len(outStr) > 0 {
 		_, _ = t.outputMirror.Write([]byte(outStr))
 		_, _ = t.outputMirror.Write([]byte("\n"))
@@ -810,14 +1056,19 @@ func (t *Table) render(out *strings.Builder) string {
 func (t *Table) reset() {
 	t.autoIndexVIndexMaxLength = 0
 	t.columnIsNonNumeric = nil
+	t.hiddenColumns = nil
+	t.markdownColumnWidths = nil
 	t.maxColumnLengths = nil
 	t.maxRowLength = 0
 	t.numColumns = 0
 	t.rowsColors = nil
+	t.rowsColorsByRules = nil
+	t.rowsCharts = nil
 	t.rowSeparator = nil
 	t.rows = nil
 	t.rowsFooter = nil
 	t.rowsHeader = nil
+	t.sortedRowIndices = nil
 }
 
 func (t *Table) shouldMergeCellsHorizontallyAbove(row rowStr, colIdx int, hint renderHint) bool {
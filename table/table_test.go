@@ -2,6 +2,7 @@ package table
 
 import (
 	"strings"
+	"sync"
 	"testing"
 	"unicode/utf8"
 
@@ -299,6 +300,80 @@ func TestTable_SetCaption(t *testing.T) {
 	assert.Equal(t, testCaption, table.caption)
 }
 
+func TestTable_SetColorProfile(t *testing.T) {
+	table := Table{}
+	table.AppendRow(Row{1, text.FgRGB(255, 0, 0).Sprint("Arya"), "Stark"})
+	table.Style().Color.Row = text.Colors{text.BgRGB(0, 0, 200)}
+	table.Style().Options.DrawBorder = false
+	table.Style().Options.SeparateColumns = false
+	assert.Nil(t, table.colorProfile)
+	assert.Equal(t, text.GetColorProfile(), table.getColorProfile())
+
+	table.SetColorProfile(text.ColorProfileNone)
+	assert.Equal(t, " 1  Arya  Stark ", table.Render())
+
+	table.SetColorProfile(text.ColorProfileANSI)
+	assert.Equal(t, "\x1b[44m 1 \x1b[0m"+
+		"\x1b[44m \x1b[91mArya\x1b[0m\x1b[44m \x1b[0m"+
+		"\x1b[44m Stark \x1b[0m", table.Render())
+
+	table.SetColorProfile(text.ColorProfileANSI256)
+	assert.Equal(t, "\x1b[48;5;20m 1 \x1b[0m"+
+		"\x1b[48;5;20m \x1b[38;5;196mArya\x1b[0m\x1b[48;5;20m \x1b[0m"+
+		"\x1b[48;5;20m Stark \x1b[0m", table.Render())
+
+	table.SetColorProfile(text.ColorProfileTrueColor)
+	assert.Equal(t, "\x1b[48;2;0;0;200m 1 \x1b[0m"+
+		"\x1b[48;2;0;0;200m \x1b[38;2;255;0;0mArya\x1b[0m\x1b[48;2;0;0;200m \x1b[0m"+
+		"\x1b[48;2;0;0;200m Stark \x1b[0m", table.Render())
+}
+
+func TestTable_SetColorProfile_Concurrency(t *testing.T) {
+	defer text.EnableColors()
+
+	style := StyleColoredBright
+	newTable := func(profile text.ColorProfile) *Table {
+		table := &Table{}
+		table.AppendHeader(testHeader)
+		table.AppendRows(testRows)
+		table.SetColorProfile(profile)
+		table.SetStyle(style)
+		return table
+	}
+	tableColored, tablePlain := newTable(text.ColorProfileTrueColor), newTable(text.ColorProfileNone)
+	expectedColored, expectedPlain := tableColored.Render(), tablePlain.Render()
+	assert.Contains(t, expectedColored, text.EscapeReset)
+	assert.Equal(t, text.StripEscape(expectedColored), expectedPlain)
+
+	var wg sync.WaitGroup
+	wg.Add(3)
+	go func() {
+		defer wg.Done()
+		for idx := 0; idx < 50; idx++ {
+			if idx%2 == 0 {
+				text.DisableColors()
+			} else {
+				text.EnableColors()
+			}
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		table := newTable(text.ColorProfileTrueColor)
+		for idx := 0; idx < 50; idx++ {
+			assert.Equal(t, expectedColored, table.Render())
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		table := newTable(text.ColorProfileNone)
+		for idx := 0; idx < 50; idx++ {
+			assert.Equal(t, expectedPlain, table.Render())
+		}
+	}()
+	wg.Wait()
+}
+
 func TestTable_SetColumnConfigs(t *testing.T) {
 	table := Table{}
 	assert.Empty(t, table.columnConfigs)
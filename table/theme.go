@@ -0,0 +1,82 @@
+package table
+
+import (
+	"fmt"
+
+	"github.com/jedib0t/go-pretty/v6/internal/theme"
+)
+
+var (
+	// stylesRegistry contains all the registered Styles; starts off with all
+	// the built-in Styles
+	stylesRegistry = theme.NewRegistry(
+		func(style interface{}) string { return style.(Style).Name },
+		StyleDefault,
+		StyleBold,
+		StyleColoredBright,
+		StyleColoredDark,
+		StyleColoredBlackOnBlueWhite,
+		StyleColoredBlackOnCyanWhite,
+		StyleColoredBlackOnGreenWhite,
+		StyleColoredBlackOnMagentaWhite,
+		StyleColoredBlackOnYellowWhite,
+		StyleColoredBlackOnRedWhite,
+		StyleColoredBlueWhiteOnBlack,
+		StyleColoredCyanWhiteOnBlack,
+		StyleColoredGreenWhiteOnBlack,
+		StyleColoredMagentaWhiteOnBlack,
+		StyleColoredRedWhiteOnBlack,
+		StyleColoredYellowWhiteOnBlack,
+		StyleDouble,
+		StyleLight,
+		StyleRounded,
+	)
+)
+
+// RegisterStyle adds the Style to the registry of Styles that can be looked up
+// by name using StyleByName. A Style registered with the name of an existing
+// one replaces it.
+func RegisterStyle(style Style) error {
+	return stylesRegistry.Register(style.Name, style)
+}
+
+// StyleByName returns the registered (or built-in) Style with the given name.
+// The lookup is case-insensitive, and the "Style" prefix is optional; for ex.,
+// "StyleColoredBright", "coloredbright" and "colored-bright" all return
+// StyleColoredBright.
+func StyleByName(name string) (Style, bool) {
+	if style, ok := stylesRegistry.Get(name); ok {
+		return style.(Style), true
+	}
+	return Style{}, false
+}
+
+// StyleFromJSON returns the Style defined in the JSON theme. Anything not
+// defined in the theme is left as it is in StyleDefault. Colors are defined
+// using their names (see text.Color.Name); for ex.:
+//  {
+//    "Name": "MyStyle",
+//    "Box": {"MiddleHorizontal": "=", "PaddingLeft": "  "},
+//    "Color": {"Header": ["bg-hi-cyan", "fg-black"], "Row": ["fg-#ff8800"]},
+//    "Format": {"Header": "title"},
+//    "Options": {"SeparateRows": true}
+//  }
+func StyleFromJSON(data []byte) (Style, error) {
+	style := StyleDefault
+	if err := theme.FromJSON(data, &style); err != nil {
+		return Style{}, fmt.Errorf("failed to parse style: %v", err)
+	}
+	return style, nil
+}
+
+// StyleNames returns the names of all the registered (and built-in) Styles in
+// sorted order.
+func StyleNames() []string {
+	return stylesRegistry.Names()
+}
+
+// JSON returns the Style as a JSON theme that can be loaded back using
+// StyleFromJSON.
+func (s Style) JSON() ([]byte, error) {
+	return theme.ToJSON(s)
+}
@@ -0,0 +1,117 @@
+package table
+
+import (
+	"testing"
+
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegisterStyle(t *testing.T) {
+	defer stylesRegistry.Unregister("StyleTest")
+
+	assert.EqualError(t, RegisterStyle(Style{}), "cannot register a style without a name")
+
+	style := StyleLight
+	style.Name = "StyleTest"
+	assert.Nil(t, RegisterStyle(style))
+	styleRegistered, ok := StyleByName("test")
+	assert.True(t, ok)
+	assert.Equal(t, style, styleRegistered)
+	assert.Contains(t, StyleNames(), "StyleTest")
+
+	style.Box = StyleBoxDouble
+	assert.Nil(t, RegisterStyle(style))
+	styleRegistered, ok = StyleByName("StyleTest")
+	assert.True(t, ok)
+	assert.Equal(t, StyleBoxDouble, styleRegistered.Box)
+}
+
+func TestStyleByName(t *testing.T) {
+	for _, name := range []string{"StyleColoredBright", "stylecoloredbright", "ColoredBright", "colored-bright", " colored_bright "} {
+		style, ok := StyleByName(name)
+		assert.True(t, ok, name)
+		assert.Equal(t, StyleColoredBright, style, name)
+	}
+
+	style, ok := StyleByName("default")
+	assert.True(t, ok)
+	assert.Equal(t, StyleDefault, style)
+
+	_, ok = StyleByName("foo")
+	assert.False(t, ok)
+	_, ok = StyleByName("style")
+	assert.False(t, ok)
+}
+
+func TestStyleFromJSON(t *testing.T) {
+	style, err := StyleFromJSON([]byte(`{
+		"Name": "MyStyle",
+		"Box": {"MiddleHorizontal": "=", "PaddingLeft": "  "},
+		"Color": {"Header": ["bg-hi-cyan", "FgBlack"], "Row": ["fg-#ff8800"]},
+		"Format": {"Header": "title"},
+		"Options": {"SeparateRows": true},
+		"Title": {"Align": "center"}
+	}`))
+	assert.Nil(t, err)
+
+	expectedStyle := StyleDefault
+	expectedStyle.Name = "MyStyle"
+	expectedStyle.Box.MiddleHorizontal = "="
+	expectedStyle.Box.PaddingLeft = "  "
+	expectedStyle.Color.Header = text.Colors{text.BgHiCyan, text.FgBlack}
+	expectedStyle.Color.Row = text.Colors{text.FgRGB(255, 136, 0)}
+	expectedStyle.Format.Header = text.FormatTitle
+	expectedStyle.Options.SeparateRows = true
+	expectedStyle.Title.Align = text.AlignCenter
+	assert.Equal(t, expectedStyle, style)
+	assert.Nil(t, StyleDefault.Color.Header, "should not modify StyleDefault")
+
+	table := Table{}
+	table.AppendHeader(Row{"#", "Name"})
+	table.AppendRow(Row{1, "Arya"})
+	table.SetStyle(style)
+	table.SetColorProfile(text.ColorProfileNone)
+	assert.Equal(t, `+====+=======+
+|  # |  Name |
++====+=======+
+|  1 |  Arya |
++====+=======+`, table.Render())
+
+	_, err = StyleFromJSON([]byte(`{"Color": {"Header": ["foo"]}}`))
+	assert.EqualError(t, err, `failed to parse style: invalid color "foo"`)
+	_, err = StyleFromJSON([]byte(`{"Format": {"Header": "bar"}}`))
+	assert.EqualError(t, err, `failed to parse style: invalid format "bar"`)
+	_, err = StyleFromJSON([]byte(`{`))
+	assert.EqualError(t, err, "failed to parse style: unexpected end of JSON input")
+}
+
+func TestStyleNames(t *testing.T) {
+	names := StyleNames()
+	assert.Len(t, names, 19)
+	assert.Equal(t, "StyleBold", names[0])
+	assert.Equal(t, "StyleRounded", names[len(names)-1])
+	assert.Contains(t, names, "StyleColoredBright")
+}
+
+func TestStyle_JSON(t *testing.T) {
+	for _, name := range StyleNames() {
+		style, _ := StyleByName(name)
+		data, err := style.JSON()
+		assert.Nil(t, err, name)
+
+		styleUnmarshaled, err := StyleFromJSON(data)
+		assert.Nil(t, err, name)
+		assert.Equal(t, style, styleUnmarshaled, name)
+	}
+
+	data, err := StyleColoredBright.JSON()
+	assert.Nil(t, err)
+	assert.Contains(t, string(data), `"Name": "StyleColoredBright",`)
+	assert.Contains(t, string(data), `"EmptyColumn": "&nbsp;",`)
+	assert.Contains(t, string(data), `"Header": [
+      "bg-hi-cyan",
+      "fg-black"
+    ],`)
+	assert.Contains(t, string(data), `"Footer": "upper",`)
+}
@@ -1,7 +1,11 @@
 package table
 
 import (
+	"math"
 	"reflect"
+	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/text"
 )
 
 // AutoIndexColumnID returns a unique Column ID/Name for the given Column Number.
@@ -28,9 +32,102 @@ func isNumber(x interface{}) bool {
 	return false
 }
 
+// isFinite returns true if the number is neither NaN nor infinite.
+func isFinite(number float64) bool {
+	return !math.IsNaN(number) && !math.IsInf(number, 0)
+}
+
+// toFloat64 returns the numeric value of the argument as a float64 if it is a
+// numeric type.
+func toFloat64(x interface{}) (float64, bool) {
+	if x == nil {
+		return 0, false
+	}
+	v := reflect.ValueOf(x)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), true
+	}
+	return 0, false
+}
+
+// toFloat64Slice returns the numeric values of the argument as a []float64 if
+// it is a slice (or an array) of numeric types.
+func toFloat64Slice(x interface{}) ([]float64, bool) {
+	if x == nil {
+		return nil, false
+	}
+	v := reflect.ValueOf(x)
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return nil, false
+	}
+	rsp := make([]float64, v.Len())
+	for idx := range rsp {
+		number, ok := toFloat64(v.Index(idx).Interface())
+		if !ok {
+			return nil, false
+		}
+		rsp[idx] = number
+	}
+	return rsp, true
+}
+
 // WidthEnforcer is a function that helps enforce a width condition on a string.
 type WidthEnforcer func(col string, maxLen int) string
 
+// Ready-to-use WidthEnforcer functions that can be set as
+// ColumnConfig.WidthMaxEnforcer.
+var (
+	// WidthEnforcerHyphenated wraps words that do not fit within a line with a
+	// trailing hyphen. Ex.: "Supercalifragilistic" => "Superca-\nlifragi-\nlistic"
+	WidthEnforcerHyphenated WidthEnforcer = text.WrapHyphenated
+	// WidthEnforcerKeepURLs wraps words while keeping URLs intact so that they
+	// remain usable. Note that this will expand the column beyond WidthMax if
+	// a URL is longer than WidthMax.
+	WidthEnforcerKeepURLs WidthEnforcer = text.WrapSoftKeepURLs
+	// WidthEnforcerSnipLeft drops text from the left of every line, and is
+	// useful for long IDs. Ex.: "a1b2c3d4e5f6" => "…d4e5f6"
+	WidthEnforcerSnipLeft = newWidthEnforcerPerLine(func(line string, maxLen int) string {
+		return text.SnipLeft(line, maxLen, snipIndicator)
+	})
+	// WidthEnforcerSnipMiddle drops text from the middle of every line, and is
+	// useful for file-system paths. Ex.: "/usr/local/bin" => "/usr/…/bin"
+	WidthEnforcerSnipMiddle = newWidthEnforcerPerLine(func(line string, maxLen int) string {
+		return text.SnipMiddle(line, maxLen, snipIndicator)
+	})
+	// WidthEnforcerSnipRight drops text from the right of every line.
+	// Ex.: "You know nothing, Jon Snow!" => "You know nothing,…"
+	WidthEnforcerSnipRight = newWidthEnforcerPerLine(func(line string, maxLen int) string {
+		return text.Snip(line, maxLen, snipIndicator)
+	})
+	// WidthEnforcerWrapSoft wraps words and moves them to the next line when
+	// they do not fit within the current line.
+	WidthEnforcerWrapSoft WidthEnforcer = text.WrapSoft
+
+	// snipIndicator is the text used to denote snipped content by the
+	// WidthEnforcerSnip* functions
+	snipIndicator = "…"
+)
+
+// newWidthEnforcerPerLine returns a WidthEnforcer that enforces the width on
+// every line of the string independently.
+func newWidthEnforcerPerLine(enforcer WidthEnforcer) WidthEnforcer {
+	return func(col string, maxLen int) string {
+		if !strings.Contains(col, "\n") {
+			return enforcer(col, maxLen)
+		}
+		lines := strings.Split(col, "\n")
+		for idx, line := range lines {
+			lines[idx] = enforcer(line, maxLen)
+		}
+		return strings.Join(lines, "\n")
+	}
+}
+
 // widthEnforcerNone returns the input string as is without any modifications.
 func widthEnforcerNone(col string, maxLen int) string {
 	return col
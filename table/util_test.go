@@ -50,3 +50,48 @@ func TestIsNumber(t *testing.T) {
 	assert.True(t, isNumber(float64(1)))
 	assert.False(t, isNumber("1"))
 }
+
+func TestWidthEnforcerPresets(t *testing.T) {
+	assert.Equal(t, "/usr/…/bin", WidthEnforcerSnipMiddle("/usr/local/bin", 10))
+	assert.Equal(t, "/usr/…/bin\n/opt", WidthEnforcerSnipMiddle("/usr/local/bin\n/opt", 10))
+	assert.Equal(t, "…d4e5f6", WidthEnforcerSnipLeft("a1b2c3d4e5f6", 7))
+	assert.Equal(t, "…d4e5f6\nab", WidthEnforcerSnipLeft("a1b2c3d4e5f6\nab", 7))
+	assert.Equal(t, "a1b2c3…", WidthEnforcerSnipRight("a1b2c3d4e5f6", 7))
+	assert.Equal(t, "Snow-\nball", WidthEnforcerHyphenated("Snowball", 5))
+	assert.Equal(t, "see  \nhttps://x.io/y", WidthEnforcerKeepURLs("see https://x.io/y", 5))
+	assert.Equal(t, "Jon  \nSnow", WidthEnforcerWrapSoft("Jon Snow", 5))
+}
+
+func TestToFloat64(t *testing.T) {
+	for _, val := range []interface{}{int(1), int8(1), int64(1), uint(1), uint32(1), float32(1), float64(1)} {
+		number, ok := toFloat64(val)
+		assert.True(t, ok, val)
+		assert.Equal(t, 1.0, number, val)
+	}
+
+	_, ok := toFloat64("1")
+	assert.False(t, ok)
+	_, ok = toFloat64(nil)
+	assert.False(t, ok)
+}
+
+func TestToFloat64Slice(t *testing.T) {
+	numbers, ok := toFloat64Slice([]int{1, 2, 3})
+	assert.True(t, ok)
+	assert.Equal(t, []float64{1, 2, 3}, numbers)
+
+	numbers, ok = toFloat64Slice([2]float32{1.5, 2})
+	assert.True(t, ok)
+	assert.Equal(t, []float64{1.5, 2}, numbers)
+
+	numbers, ok = toFloat64Slice([]interface{}{1, 2.5})
+	assert.True(t, ok)
+	assert.Equal(t, []float64{1, 2.5}, numbers)
+
+	_, ok = toFloat64Slice([]interface{}{1, "2"})
+	assert.False(t, ok)
+	_, ok = toFloat64Slice(1)
+	assert.False(t, ok)
+	_, ok = toFloat64Slice(nil)
+	assert.False(t, ok)
+}
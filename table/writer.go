@@ -2,6 +2,8 @@ package table
 
 import (
 	"io"
+
+	"github.com/jedib0t/go-pretty/v6/text"
 )
 
 // Writer declares the interfaces that can be used to setup and render a table.
@@ -15,13 +17,19 @@ type Writer interface {
 	Render() string
 	RenderCSV() string
 	RenderHTML() string
+	RenderHTMLDocument(options HTMLDocumentOptions) string
 	RenderMarkdown() string
+	RenderODS(w io.Writer) error
+	RenderPNG(w io.Writer) error
+	RenderSVG() string
+	RenderXLSX(w io.Writer) error
 	ResetFooters()
 	ResetHeaders()
 	ResetRows()
 	SetAllowedRowLength(length int)
 	SetAutoIndex(autoIndex bool)
 	SetCaption(format string, a ...interface{})
+	SetColorProfile(profile text.ColorProfile)
 	SetColumnConfigs(configs []ColumnConfig)
 	SetIndexColumn(colNum int)
 	SetOutputMirror(mirror io.Writer)
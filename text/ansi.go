@@ -1,11 +1,28 @@
 package text
 
-import "strings"
+import (
+	"io"
+	"strings"
+)
 
 // ANSICodesSupported will be true on consoles where ANSI Escape Codes/Sequences
 // are supported.
 var ANSICodesSupported = areANSICodesSupported()
 
+// IsTerminal returns true if the given output is a terminal (that supports
+// ANSI Escape Codes/Sequences). Output redirected to a file or a pipe (like
+// when running in CI) is not a terminal.
+func IsTerminal(w io.Writer) bool {
+	return isTerminal(w)
+}
+
+// TerminalWidth returns the width (number of columns) of the terminal the
+// given output is attached to, or 0 if the output is not a terminal or the
+// width cannot be determined.
+func TerminalWidth(w io.Writer) int {
+	return getTerminalWidth(w)
+}
+
 // Escape encodes the string with the ANSI Escape Sequence.
 // For ex.:
 //  Escape("Ghost", "") == "Ghost"
@@ -39,17 +56,11 @@ func StripEscape(str string) string {
 	var out strings.Builder
 	out.Grow(RuneCount(str))
 
-	isEscSeq := false
+	var parser escSeqParser
 	for _, sChr := range str {
-		if sChr == EscapeStartRune {
-			isEscSeq = true
-		}
-		if !isEscSeq {
+		if !parser.consume(sChr) {
 			out.WriteRune(sChr)
 		}
-		if isEscSeq && sChr == EscapeStopRune {
-			isEscSeq = false
-		}
 	}
 	return out.String()
 }
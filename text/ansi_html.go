@@ -0,0 +1,196 @@
+package text
+
+import (
+	"strconv"
+	"strings"
+)
+
+// ANSIToHTML converts the color escape sequences (SGR sequences, including the
+// ones for 256-color and RGB colors) in the string into <span> tags with the
+// CSS classes for the colors (see GenerateCSS); colors without an equivalent
+// CSS class (256-color and RGB colors) get inline styles instead. The text
+// itself is not escaped; use html.EscapeString on the string before this if
+// needed. Other escape sequences (like hyperlinks) are retained as is. For ex.:
+//  ANSIToHTML("\x1b[1;91mGhost\x1b[0m Lady") == "<span class=\"bold fg-hi-red\">Ghost</span> Lady"
+//  ANSIToHTML("\x1b[38;5;208mGhost\x1b[0m") == "<span style=\"color: #ff8700\">Ghost</span>"
+func ANSIToHTML(str string) string {
+	return ansiToHTML(str, false)
+}
+
+// ANSIToHTMLInline is the same as ANSIToHTML, but renders all the colors as
+// inline styles instead of CSS classes; useful in cases where stylesheets
+// cannot be used, like in HTML e-mails. For ex.:
+//  ANSIToHTMLInline("\x1b[1;91mGhost\x1b[0m Lady") == "<span style=\"font-weight: bold; color: #ff0000\">Ghost</span> Lady"
+func ANSIToHTMLInline(str string) string {
+	return ansiToHTML(str, true)
+}
+
+func ansiToHTML(str string, inlineCSS bool) string {
+	if !strings.ContainsRune(str, EscapeStartRune) {
+		return str
+	}
+
+	var out strings.Builder
+	out.Grow(len(str))
+	var parser escSeqParser
+	var state sgrState
+	var spanOpen, spanRendered string
+	renderSpan := func() {
+		// open a new span only if the colors changed since the last one
+		if spanOpen != spanRendered {
+			if spanRendered != "" {
+				out.WriteString("</span>")
+			}
+			out.WriteString(spanOpen)
+			spanRendered = spanOpen
+		}
+	}
+	for _, c := range str {
+		// write out the incomplete escape sequence that is being abandoned
+		if c == EscapeStartRune && parser.inEscSeq && !parser.isOSC {
+			out.WriteString(parser.seq.String())
+		}
+		if !parser.consume(c) {
+			renderSpan()
+			out.WriteRune(c)
+		} else if escSeq := parser.completedCSI(); escSeq != "" {
+			state.apply(escSeq)
+			spanOpen = state.htmlSpan(inlineCSS)
+		} else if parser.completed != "" {
+			// render the span before things like hyperlinks so that they
+			// end up nested properly once converted to HTML
+			renderSpan()
+			out.WriteString(parser.completed)
+		}
+	}
+	if parser.inEscSeq {
+		out.WriteString(parser.seq.String())
+	}
+	if spanRendered != "" {
+		out.WriteString("</span>")
+	}
+	return out.String()
+}
+
+// sgrState tracks the colors set by the SGR escape sequences seen so far.
+type sgrState struct {
+	attributes []Color // Bold, Italic, etc. in the order they were set
+	bg         Color
+	fg         Color
+	hasBg      bool
+	hasFg      bool
+}
+
+// apply updates the state with the SGR parameters in the escape sequence.
+func (s *sgrState) apply(escSeq string) {
+	if !strings.HasSuffix(escSeq, EscapeStop) {
+		return
+	}
+	params := strings.Split(escSeq[len(EscapeStart):len(escSeq)-len(EscapeStop)], ";")
+
+	for idx := 0; idx < len(params); idx++ {
+		if color, numParams := parseExtendedColor(params[idx:]); numParams > 0 {
+			if color&colorFlagBg != 0 {
+				s.bg, s.hasBg = color, true
+			} else {
+				s.fg, s.hasFg = color, true
+			}
+			idx += numParams - 1
+			continue
+		}
+
+		code := 0
+		if params[idx] != "" {
+			var err error
+			if code, err = strconv.Atoi(params[idx]); err != nil {
+				continue
+			}
+		}
+		switch {
+		case code == 0:
+			*s = sgrState{}
+		case code >= int(Bold) && code <= int(CrossedOut):
+			s.addAttribute(Color(code))
+		case code == 21 || code == 22:
+			s.removeAttributes(Bold, Faint)
+		case code >= 23 && code <= 29:
+			s.removeAttributes(sgrAttributesReset[code]...)
+		case (code >= int(FgBlack) && code <= int(FgWhite)) || (code >= int(FgHiBlack) && code <= int(FgHiWhite)):
+			s.fg, s.hasFg = Color(code), true
+		case code == 39:
+			s.hasFg = false
+		case (code >= int(BgBlack) && code <= int(BgWhite)) || (code >= int(BgHiBlack) && code <= int(BgHiWhite)):
+			s.bg, s.hasBg = Color(code), true
+		case code == 49:
+			s.hasBg = false
+		}
+	}
+}
+
+func (s *sgrState) addAttribute(color Color) {
+	for _, attribute := range s.attributes {
+		if attribute == color {
+			return
+		}
+	}
+	s.attributes = append(s.attributes, color)
+}
+
+func (s *sgrState) removeAttributes(colors ...Color) {
+	attributes := s.attributes[:0]
+	for _, attribute := range s.attributes {
+		keep := true
+		for _, color := range colors {
+			if attribute == color {
+				keep = false
+				break
+			}
+		}
+		if keep {
+			attributes = append(attributes, attribute)
+		}
+	}
+	s.attributes = attributes
+}
+
+// colors returns all the colors in effect.
+func (s sgrState) colors() Colors {
+	colors := make(Colors, 0, len(s.attributes)+2)
+	colors = append(colors, s.attributes...)
+	if s.hasFg {
+		colors = append(colors, s.fg)
+	}
+	if s.hasBg {
+		colors = append(colors, s.bg)
+	}
+	return colors
+}
+
+// htmlSpan returns the <span> tag to render the colors in effect, or an empty
+// string if there are none.
+func (s sgrState) htmlSpan(inlineCSS bool) string {
+	colors := s.colors()
+	if len(colors) == 0 {
+		return ""
+	}
+	if inlineCSS {
+		if style := colors.CSSStyle(); style != "" {
+			return "<span style=\"" + style + "\">"
+		}
+		return ""
+	}
+	if property := colors.HTMLProperty(); property != "" {
+		return "<span " + property + ">"
+	}
+	return ""
+}
+
+// sgrAttributesReset contains the attributes reset by the SGR codes 23 to 29.
+var sgrAttributesReset = map[int][]Color{
+	23: {Italic},
+	24: {Underline},
+	25: {BlinkSlow, BlinkRapid},
+	27: {ReverseVideo},
+	28: {Concealed},
+	29: {CrossedOut},
+}
@@ -0,0 +1,81 @@
+package text
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func ExampleANSIToHTML() {
+	fmt.Printf("ANSIToHTML(%#v) == %#v\n", "\x1b[1;91mGhost\x1b[0m Lady", ANSIToHTML("\x1b[1;91mGhost\x1b[0m Lady"))
+	fmt.Printf("ANSIToHTML(%#v) == %#v\n", "\x1b[38;5;208mGhost\x1b[0m", ANSIToHTML("\x1b[38;5;208mGhost\x1b[0m"))
+
+	// Output: ANSIToHTML("\x1b[1;91mGhost\x1b[0m Lady") == "<span class=\"bold fg-hi-red\">Ghost</span> Lady"
+	// ANSIToHTML("\x1b[38;5;208mGhost\x1b[0m") == "<span style=\"color: #ff8700\">Ghost</span>"
+}
+
+func ExampleANSIToHTMLInline() {
+	fmt.Printf("ANSIToHTMLInline(%#v) == %#v\n", "\x1b[1;91mGhost\x1b[0m Lady", ANSIToHTMLInline("\x1b[1;91mGhost\x1b[0m Lady"))
+
+	// Output: ANSIToHTMLInline("\x1b[1;91mGhost\x1b[0m Lady") == "<span style=\"font-weight: bold; color: #ff0000\">Ghost</span> Lady"
+}
+
+func TestANSIToHTML(t *testing.T) {
+	assert.Equal(t, "", ANSIToHTML(""))
+	assert.Equal(t, "Ghost", ANSIToHTML("Ghost"))
+	assert.Equal(t, "Ghost", ANSIToHTML("\x1b[0mGhost\x1b[m"))
+	assert.Equal(t, "<span class=\"fg-hi-red\">Ghost</span>", ANSIToHTML(FgHiRed.Sprint("Ghost")))
+	assert.Equal(t, "<span class=\"bg-black fg-hi-red\">Ghost</span>", ANSIToHTML(Colors{FgHiRed, BgBlack}.Sprint("Ghost")))
+	assert.Equal(t, "<span class=\"fg-hi-red\">Ghost</span> <span class=\"fg-hi-blue\">Lady</span>",
+		ANSIToHTML(FgHiRed.Sprint("Ghost")+" "+FgHiBlue.Sprint("Lady")))
+	assert.Equal(t, "<span class=\"fg-hi-blue\">Nymeria</span><span class=\"fg-hi-red\">Ghost</span><span class=\"fg-hi-blue\">Lady</span>",
+		ANSIToHTML(Escape("Nymeria"+FgHiRed.Sprint("Ghost")+"Lady", FgHiBlue.EscapeSeq())))
+	assert.Equal(t, "<span class=\"fg-red\">Ghost\nLady</span>", ANSIToHTML("\x1b[31mGhost\nLady\x1b[0m"))
+
+	// consecutive escape sequences without any text between them
+	assert.Equal(t, "<span class=\"bold fg-red\">Ghost</span>", ANSIToHTML("\x1b[31m\x1b[1mGhost\x1b[0m"))
+	assert.Equal(t, "Ghost", ANSIToHTML("\x1b[31m\x1b[0mGhost"))
+	assert.Equal(t, "<span class=\"fg-red\">Gh</span>ost", ANSIToHTML("\x1b[31mGh\x1b[39m\x1b[0most"))
+
+	// extended colors
+	assert.Equal(t, "<span style=\"color: #ff8700\">Ghost</span>", ANSIToHTML(Fg256(208).Sprint("Ghost")))
+	assert.Equal(t, "<span style=\"background-color: #102030\">Ghost</span>", ANSIToHTML(BgRGB(0x10, 0x20, 0x30).Sprint("Ghost")))
+	assert.Equal(t, "<span class=\"bold\" style=\"color: #ff8700; background-color: #102030\">Ghost</span>",
+		ANSIToHTML("\x1b[1;38;5;208;48;2;16;32;48mGhost\x1b[0m"))
+
+	// other escape sequences are retained as is
+	assert.Equal(t, "<span class=\"fg-red\">"+Hyperlink("https://github.com", "Ghost")+"</span>",
+		ANSIToHTML(FgRed.Sprint(Hyperlink("https://github.com", "Ghost"))))
+	assert.Equal(t, "<a href=\"https://github.com\"><span class=\"fg-red\">Ghost</span></a>",
+		ReplaceHyperlinks(ANSIToHTML(Hyperlink("https://github.com", FgRed.Sprint("Ghost"))), func(url, label string) string {
+			return "<a href=\"" + url + "\">" + label + "</a>"
+		}))
+	assert.Equal(t, "Ghost\x1b[31", ANSIToHTML("Ghost\x1b[31"))
+}
+
+func TestANSIToHTML_Reset(t *testing.T) {
+	// attributes getting reset individually
+	assert.Equal(t, "<span class=\"bold italic\">A</span><span class=\"italic\">B</span>C",
+		ANSIToHTML("\x1b[1;3mA\x1b[22mB\x1b[23mC"))
+	assert.Equal(t, "<span class=\"blink-slow crossed-out underline\">A</span><span class=\"crossed-out\">B</span>C",
+		ANSIToHTML("\x1b[4;5;9mA\x1b[24;25mB\x1b[29mC"))
+	assert.Equal(t, "<span class=\"concealed reverse-video\">A</span>B",
+		ANSIToHTML("\x1b[7;8mA\x1b[27;28mB"))
+
+	// colors getting reset individually
+	assert.Equal(t, "<span class=\"bg-blue fg-red\">A</span><span class=\"bg-blue\">B</span>C",
+		ANSIToHTML("\x1b[31;44mA\x1b[39mB\x1b[49mC"))
+	assert.Equal(t, "<span class=\"bg-hi-blue fg-hi-red\">A</span>B",
+		ANSIToHTML("\x1b[91;104mA\x1b[0mB"))
+
+	// unknown codes are ignored
+	assert.Equal(t, "<span class=\"fg-red\">A</span>", ANSIToHTML("\x1b[31;foo;75mA"))
+}
+
+func TestANSIToHTMLInline(t *testing.T) {
+	assert.Equal(t, "Ghost", ANSIToHTMLInline("Ghost"))
+	assert.Equal(t, "<span style=\"color: #ff0000\">Ghost</span>", ANSIToHTMLInline(FgHiRed.Sprint("Ghost")))
+	assert.Equal(t, "<span style=\"font-weight: bold; color: #ff8700; background-color: #102030\">Ghost</span>",
+		ANSIToHTMLInline("\x1b[1;38;5;208;48;2;16;32;48mGhost\x1b[0m"))
+}
@@ -2,6 +2,9 @@ package text
 
 import (
 	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -28,11 +31,37 @@ func ExampleEscape() {
 	// Escape("Nymeria \x1b[94mGhost\x1b[0m Lady", "\x1b[91m") == "\x1b[91mNymeria \x1b[94mGhost\x1b[0m\x1b[91m Lady\x1b[0m"
 }
 
+func TestIsTerminal(t *testing.T) {
+	assert.False(t, IsTerminal(nil))
+	assert.False(t, IsTerminal(&strings.Builder{}))
+
+	file, err := ioutil.TempFile("", "go-pretty-text-")
+	if assert.Nil(t, err) {
+		defer os.Remove(file.Name())
+		defer file.Close()
+		assert.False(t, IsTerminal(file))
+	}
+}
+
+func TestTerminalWidth(t *testing.T) {
+	assert.Equal(t, 0, TerminalWidth(nil))
+	assert.Equal(t, 0, TerminalWidth(&strings.Builder{}))
+
+	file, err := ioutil.TempFile("", "go-pretty-text-")
+	if assert.Nil(t, err) {
+		defer os.Remove(file.Name())
+		defer file.Close()
+		assert.Equal(t, 0, TerminalWidth(file))
+	}
+}
+
 func TestStripEscape(t *testing.T) {
 	assert.Equal(t, "Ghost", StripEscape(FgHiRed.Sprint("Ghost")))
 	assert.Equal(t, "GhostLady", StripEscape(FgHiBlue.Sprint("Ghost")+"Lady"))
 	assert.Equal(t, "NymeriaGhostLady", StripEscape("Nymeria"+FgHiBlue.Sprint("Ghost")+"Lady"))
 	assert.Equal(t, "Nymeria Ghost Lady", StripEscape("Nymeria "+FgHiBlue.Sprint("Ghost")+" Lady"))
+	assert.Equal(t, "Nymeria Ghost Lady", StripEscape("Nymeria "+Hyperlink("https://example.com/m", "Ghost")+" Lady"))
+	assert.Equal(t, "Nymeria Ghost Lady", StripEscape("Nymeria \x1b]8;;https://example.com/m\aGhost\x1b]8;;\a Lady"))
 }
 
 func ExampleStripEscape() {
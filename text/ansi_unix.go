@@ -2,6 +2,21 @@
 
 package text
 
+import (
+	"io"
+	"os"
+)
+
 func areANSICodesSupported() bool {
 	return true
 }
+
+// isTerminal returns true if the writer is a terminal (character device).
+func isTerminal(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	if !ok || f == nil {
+		return false
+	}
+	stat, err := f.Stat()
+	return err == nil && stat.Mode()&os.ModeCharDevice != 0
+}
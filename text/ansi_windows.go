@@ -3,6 +3,7 @@
 package text
 
 import (
+	"io"
 	"os"
 	"sync"
 
@@ -14,10 +15,21 @@ var (
 )
 
 func areANSICodesSupported() bool {
+	return isTerminal(os.Stdout)
+}
+
+// isTerminal returns true if the writer is a console that supports (or has
+// been made to support) ANSI Escape Codes/Sequences.
+func isTerminal(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	if !ok || f == nil {
+		return false
+	}
+
 	enableVTPMutex.Lock()
 	defer enableVTPMutex.Unlock()
 
-	outHandle := windows.Handle(os.Stdout.Fd())
+	outHandle := windows.Handle(f.Fd())
 	var outMode uint32
 	if err := windows.GetConsoleMode(outHandle, &outMode); err == nil {
 		if outMode&windows.ENABLE_VIRTUAL_TERMINAL_PROCESSING != 0 {
@@ -0,0 +1,78 @@
+package text
+
+import (
+	"math"
+	"strings"
+)
+
+// Chart related variables
+var (
+	barChars       = []rune("▏▎▍▌▋▊▉█")
+	sparklineChars = []rune("▁▂▃▄▅▆▇█")
+)
+
+// Bar returns a horizontal bar made of UNICODE Block characters that fills up
+// the given fraction (0.0 to 1.0) of the given width. Partially filled
+// character cells are rendered using the 1/8th Block characters. The returned
+// string is padded with spaces to be always as long as the width. For ex.:
+//  Bar(0.0, 4) == "    "
+//  Bar(0.5, 4) == "██  "
+//  Bar(0.6, 4) == "██▍ "
+//  Bar(1.0, 4) == "████"
+func Bar(fraction float64, width int) string {
+	if width <= 0 {
+		return ""
+	}
+	if math.IsNaN(fraction) || fraction < 0 {
+		fraction = 0
+	} else if fraction > 1 {
+		fraction = 1
+	}
+
+	numEighths := int(math.Round(fraction * float64(width*len(barChars))))
+	numFull, numPartial := numEighths/len(barChars), numEighths%len(barChars)
+
+	var out strings.Builder
+	out.Grow(width * 3)
+	out.WriteString(strings.Repeat(string(barChars[len(barChars)-1]), numFull))
+	if numPartial > 0 {
+		out.WriteRune(barChars[numPartial-1])
+		numFull++
+	}
+	out.WriteString(strings.Repeat(" ", width-numFull))
+	return out.String()
+}
+
+// Sparkline returns a sparkline for the given values using UNICODE Block
+// characters, with one character per value scaled between the smallest and the
+// largest of the values. NaN and infinite values are rendered as a space. For
+// ex.:
+//  Sparkline([]float64{1, 2, 3, 4, 5, 6, 7, 8}) == "▁▂▃▄▅▆▇█"
+//  Sparkline([]float64{5, 1, 5}) == "█▁█"
+//  Sparkline([]float64{3, 3, 3}) == "▁▁▁"
+func Sparkline(values []float64) string {
+	min, max := math.Inf(1), math.Inf(-1)
+	for _, value := range values {
+		if !isNonFinite(value) {
+			min, max = math.Min(min, value), math.Max(max, value)
+		}
+	}
+
+	var out strings.Builder
+	out.Grow(len(values) * 3)
+	for _, value := range values {
+		if isNonFinite(value) {
+			out.WriteRune(' ')
+		} else if max == min {
+			out.WriteRune(sparklineChars[0])
+		} else {
+			idx := int(math.Round((value - min) / (max - min) * float64(len(sparklineChars)-1)))
+			out.WriteRune(sparklineChars[idx])
+		}
+	}
+	return out.String()
+}
+
+func isNonFinite(value float64) bool {
+	return math.IsNaN(value) || math.IsInf(value, 0)
+}
@@ -0,0 +1,53 @@
+package text
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func ExampleBar() {
+	fmt.Printf("Bar(0.0, 4): %#v\n", Bar(0.0, 4))
+	fmt.Printf("Bar(0.5, 4): %#v\n", Bar(0.5, 4))
+	fmt.Printf("Bar(0.6, 4): %#v\n", Bar(0.6, 4))
+	fmt.Printf("Bar(1.0, 4): %#v\n", Bar(1.0, 4))
+
+	// Output: Bar(0.0, 4): "    "
+	// Bar(0.5, 4): "██  "
+	// Bar(0.6, 4): "██▍ "
+	// Bar(1.0, 4): "████"
+}
+
+func TestBar(t *testing.T) {
+	assert.Equal(t, "", Bar(0.5, 0))
+	assert.Equal(t, "  ", Bar(-1, 2))
+	assert.Equal(t, "  ", Bar(math.NaN(), 2))
+	assert.Equal(t, "██", Bar(2, 2))
+	assert.Equal(t, "▏ ", Bar(0.0625, 2))
+	assert.Equal(t, "█▉", Bar(0.9375, 2))
+	for fraction := 0.0; fraction <= 1.0; fraction += 0.01 {
+		assert.Equal(t, 10, RuneCount(Bar(fraction, 10)), fraction)
+	}
+}
+
+func ExampleSparkline() {
+	fmt.Printf("Sparkline([]float64{1, 2, 3, 4, 5, 6, 7, 8}): %#v\n", Sparkline([]float64{1, 2, 3, 4, 5, 6, 7, 8}))
+	fmt.Printf("Sparkline([]float64{5, 1, 5}): %#v\n", Sparkline([]float64{5, 1, 5}))
+	fmt.Printf("Sparkline([]float64{3, 3, 3}): %#v\n", Sparkline([]float64{3, 3, 3}))
+
+	// Output: Sparkline([]float64{1, 2, 3, 4, 5, 6, 7, 8}): "▁▂▃▄▅▆▇█"
+	// Sparkline([]float64{5, 1, 5}): "█▁█"
+	// Sparkline([]float64{3, 3, 3}): "▁▁▁"
+}
+
+func TestSparkline(t *testing.T) {
+	assert.Equal(t, "", Sparkline(nil))
+	assert.Equal(t, "▁ █", Sparkline([]float64{0, math.NaN(), 10}))
+	assert.Equal(t, "▁▅█", Sparkline([]float64{-10, 0, 10}))
+	assert.Equal(t, "   ", Sparkline([]float64{math.NaN(), math.NaN(), math.NaN()}))
+	assert.Equal(t, "▁ █", Sparkline([]float64{1, math.Inf(1), 2}))
+	assert.Equal(t, "▁  █", Sparkline([]float64{1, math.Inf(-1), math.NaN(), 2}))
+	assert.Equal(t, "  ", Sparkline([]float64{math.Inf(1), math.Inf(-1)}))
+}
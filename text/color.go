@@ -1,25 +1,46 @@
 package text
 
 import (
+	"context"
 	"fmt"
+	"os"
 	"sort"
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 )
 
 var (
-	colorsEnabled = areANSICodesSupported()
+	// colorProfile is the ColorProfile in use globally; accessed atomically
+	// as it can be changed while other goroutines are rendering
+	colorProfile = int32(DetectColorProfile(os.Stdout))
 )
 
-// DisableColors (forcefully) disables color coding globally.
+// DisableColors (forcefully) disables color coding globally. This is merely
+// the default for everything that does not have its own ColorProfile; prefer
+// the SetColorProfile functions on the table/list/progress Writers (or
+// WithColorProfile) to control the colors of a single output.
 func DisableColors() {
-	colorsEnabled = false
+	SetColorProfile(ColorProfileNone)
 }
 
-// EnableColors (forcefully) enables color coding globally.
+// EnableColors (forcefully) enables color coding globally; all colors are
+// rendered as-is without being downgraded.
 func EnableColors() {
-	colorsEnabled = true
+	SetColorProfile(ColorProfileTrueColor)
+}
+
+// GetColorProfile returns the ColorProfile in use globally. It defaults to the
+// one detected for os.Stdout using DetectColorProfile.
+func GetColorProfile() ColorProfile {
+	return ColorProfile(atomic.LoadInt32(&colorProfile))
+}
+
+// SetColorProfile sets the ColorProfile to use globally. Colors not supported
+// by the profile get downgraded to the nearest supported color.
+func SetColorProfile(profile ColorProfile) {
+	atomic.StoreInt32(&colorProfile, int32(profile))
 }
 
 // The logic here is inspired from github.com/fatih/color; the following is
@@ -95,9 +116,148 @@ const (
 	BgHiWhite
 )
 
+// Extended colors (from the 256-color palette, or 24-bit RGB colors) are
+// encoded into the Color value using the following flags. The palette index or
+// the RGB value occupies the lower 24 bits.
+const (
+	colorFlagBg  Color = 1 << 24
+	colorFlag256 Color = 1 << 25
+	colorFlagRGB Color = 1 << 26
+)
+
+// Fg256 returns the foreground Color for the given index in the 256-color
+// palette supported by most terminals:
+//   * 0-7: standard colors (same as FgBlack..FgWhite)
+//   * 8-15: high intensity colors (same as FgHiBlack..FgHiWhite)
+//   * 16-231: 6x6x6 color cube
+//   * 232-255: grayscale from dark to light
+func Fg256(n uint8) Color {
+	return colorFlag256 | Color(n)
+}
+
+// Bg256 returns the background Color for the given index in the 256-color
+// palette. Refer to Fg256 for details on the palette.
+func Bg256(n uint8) Color {
+	return colorFlag256 | colorFlagBg | Color(n)
+}
+
+// FgRGB returns the 24-bit (truecolor) foreground Color for the given red,
+// green and blue components.
+func FgRGB(r, g, b uint8) Color {
+	return colorFlagRGB | Color(r)<<16 | Color(g)<<8 | Color(b)
+}
+
+// BgRGB returns the 24-bit (truecolor) background Color for the given red,
+// green and blue components.
+func BgRGB(r, g, b uint8) Color {
+	return colorFlagRGB | colorFlagBg | Color(r)<<16 | Color(g)<<8 | Color(b)
+}
+
+// FgHex returns the 24-bit (truecolor) foreground Color for the given hex
+// color code in the "#rrggbb" or "#rgb" formats (the "#" is optional). For ex.:
+//  FgHex("#ff8800") == FgRGB(255, 136, 0)
+//  FgHex("f80") == FgRGB(255, 136, 0)
+func FgHex(hex string) (Color, error) {
+	r, g, b, err := parseHexColor(hex)
+	if err != nil {
+		return Reset, err
+	}
+	return FgRGB(r, g, b), nil
+}
+
+// BgHex returns the 24-bit (truecolor) background Color for the given hex
+// color code. Refer to FgHex for details on the format.
+func BgHex(hex string) (Color, error) {
+	r, g, b, err := parseHexColor(hex)
+	if err != nil {
+		return Reset, err
+	}
+	return BgRGB(r, g, b), nil
+}
+
+// ColorByName returns the Color with the given name as returned by Color.Name.
+// The name of the Go constant (ex.: "FgHiRed") is accepted too. For ex.:
+//  ColorByName("fg-hi-red") == FgHiRed
+//  ColorByName("FgHiRed") == FgHiRed
+//  ColorByName("bg-256-208") == Bg256(208)
+//  ColorByName("fg-#ff8800") == FgRGB(255, 136, 0)
+func ColorByName(name string) (Color, error) {
+	nameNormalized := strings.ToLower(strings.TrimSpace(name))
+	for _, prefix := range []string{"fg-", "bg-"} {
+		if !strings.HasPrefix(nameNormalized, prefix) {
+			continue
+		}
+		color, isExtended := Reset, false
+		if value := strings.TrimPrefix(nameNormalized, prefix+"256-"); value != nameNormalized {
+			n, err := strconv.ParseUint(value, 10, 8)
+			if err != nil {
+				return Reset, fmt.Errorf("invalid color %#v", name)
+			}
+			color, isExtended = Fg256(uint8(n)), true
+		} else if value := strings.TrimPrefix(nameNormalized, prefix); strings.HasPrefix(value, "#") {
+			r, g, b, err := parseHexColor(value)
+			if err != nil {
+				return Reset, fmt.Errorf("invalid color %#v", name)
+			}
+			color, isExtended = FgRGB(r, g, b), true
+		}
+		if isExtended {
+			if prefix == "bg-" {
+				color |= colorFlagBg
+			}
+			return color, nil
+		}
+	}
+
+	if color, ok := colorsByName[strings.NewReplacer("-", "", "_", "").Replace(nameNormalized)]; ok {
+		return color, nil
+	}
+	return Reset, fmt.Errorf("invalid color %#v", name)
+}
+
+// Downgrade returns the nearest color supported by the ColorProfile for
+// 256-color palette and RGB colors; all other colors are returned as-is. For
+// ex.:
+//  FgRGB(255, 0, 0).Downgrade(ColorProfileANSI256) == Fg256(196)
+//  FgRGB(255, 0, 0).Downgrade(ColorProfileANSI) == FgHiRed
+func (c Color) Downgrade(profile ColorProfile) Color {
+	if !c.isExtended() || profile >= ColorProfileTrueColor || profile <= ColorProfileNone {
+		return c
+	}
+	if profile == ColorProfileANSI256 {
+		if c&colorFlag256 != 0 {
+			return c
+		}
+		return colorFlag256 | c&colorFlagBg | Color(rgbTo256(c.rgb()))
+	}
+
+	var idx uint8
+	if c&colorFlag256 != 0 && c&0xff < 16 {
+		idx = uint8(c & 0xff)
+	} else {
+		idx = rgbTo16(c.rgb())
+	}
+	color := FgBlack
+	if c&colorFlagBg != 0 {
+		color = BgBlack
+	}
+	if idx >= 8 {
+		color += FgHiBlack - FgBlack
+	}
+	return color + Color(idx%8)
+}
+
 // EscapeSeq returns the ANSI escape sequence for the color.
 func (c Color) EscapeSeq() string {
-	return EscapeStart + strconv.Itoa(int(c)) + EscapeStop
+	return EscapeStart + c.code() + EscapeStop
+}
+
+// CSSStyle returns the inline CSS declaration(s) for the color. This is useful
+// in cases where CSS classes cannot be used, like in HTML e-mails. For ex.:
+//  FgRed.CSSStyle() == "color: #cd0000"
+//  Bold.CSSStyle() == "font-weight: bold"
+func (c Color) CSSStyle() string {
+	return Colors{c}.CSSStyle()
 }
 
 // HTMLProperty returns the "class" attribute for the color.
@@ -105,18 +265,210 @@ func (c Color) HTMLProperty() string {
 	out := ""
 	if class, ok := colorCSSClassMap[c]; ok {
 		out = fmt.Sprintf("class=\"%s\"", class)
+	} else if c.isExtended() {
+		out = fmt.Sprintf("style=\"%s\"", c.CSSStyle())
 	}
 	return out
 }
 
+// Name returns the name of the color for use in configuration files (like
+// themes); the name is the same as the CSS class of the color (ex.:
+// "fg-hi-red"), "fg-256-208"/"bg-256-208" for colors from the 256-color
+// palette, and "fg-#ff8800"/"bg-#ff8800" for RGB colors. An empty string is
+// returned for values that are not valid colors. Refer to ColorByName for the
+// reverse.
+func (c Color) Name() string {
+	if c == Reset {
+		return "reset"
+	}
+	if class, ok := colorCSSClassMap[c]; ok {
+		return class
+	}
+	if c.isExtended() && c&^(colorFlag256|colorFlagRGB|colorFlagBg|0xffffff) == 0 {
+		prefix := "fg-"
+		if c&colorFlagBg != 0 {
+			prefix = "bg-"
+		}
+		if c&colorFlag256 != 0 {
+			return prefix + "256-" + strconv.Itoa(int(c&0xff))
+		}
+		r, g, b := c.rgb()
+		return fmt.Sprintf("%s#%02x%02x%02x", prefix, r, g, b)
+	}
+	return ""
+}
+
 // Sprint colorizes and prints the given string(s).
 func (c Color) Sprint(a ...interface{}) string {
-	return colorize(fmt.Sprint(a...), c.EscapeSeq())
+	return colorize(fmt.Sprint(a...), c.escapeSeq(GetColorProfile()))
 }
 
 // Sprintf formats and colorizes and prints the given string(s).
 func (c Color) Sprintf(format string, a ...interface{}) string {
-	return colorize(fmt.Sprintf(format, a...), c.EscapeSeq())
+	return colorize(fmt.Sprintf(format, a...), c.escapeSeq(GetColorProfile()))
+}
+
+// SprintContext colorizes and prints the given string(s) using the
+// ColorProfile in the context (see WithColorProfile).
+func (c Color) SprintContext(ctx context.Context, a ...interface{}) string {
+	return colorize(fmt.Sprint(a...), c.escapeSeq(ColorProfileFromContext(ctx)))
+}
+
+// SprintfContext formats and colorizes and prints the given string(s) using
+// the ColorProfile in the context (see WithColorProfile).
+func (c Color) SprintfContext(ctx context.Context, format string, a ...interface{}) string {
+	return colorize(fmt.Sprintf(format, a...), c.escapeSeq(ColorProfileFromContext(ctx)))
+}
+
+// code returns the SGR parameter(s) for the color.
+func (c Color) code() string {
+	switch {
+	case c&colorFlagRGB != 0:
+		r, g, b := c.rgb()
+		return c.codePrefix() + "2;" + strconv.Itoa(int(r)) + ";" + strconv.Itoa(int(g)) + ";" + strconv.Itoa(int(b))
+	case c&colorFlag256 != 0:
+		return c.codePrefix() + "5;" + strconv.Itoa(int(c&0xff))
+	default:
+		return strconv.Itoa(int(c))
+	}
+}
+
+// escapeSeq returns the ANSI escape sequence for the color as supported by the
+// ColorProfile.
+func (c Color) escapeSeq(profile ColorProfile) string {
+	if profile <= ColorProfileNone {
+		return ""
+	}
+	return c.Downgrade(profile).EscapeSeq()
+}
+
+// codePrefix returns the SGR parameter prefix for extended colors.
+func (c Color) codePrefix() string {
+	if c&colorFlagBg != 0 {
+		return "48;"
+	}
+	return "38;"
+}
+
+// cssStyle returns the inline CSS property and value for the color.
+func (c Color) cssStyle() (string, string, bool) {
+	if !c.isExtended() {
+		style, ok := colorCSSStyleMap[c]
+		return style[0], style[1], ok
+	}
+
+	property := "color"
+	if c&colorFlagBg != 0 {
+		property = "background-color"
+	}
+	r, g, b := c.rgb()
+	return property, fmt.Sprintf("#%02x%02x%02x", r, g, b), true
+}
+
+// isExtended returns true if the color is from the 256-color palette or is a
+// 24-bit RGB color.
+func (c Color) isExtended() bool {
+	return c&(colorFlag256|colorFlagRGB) != 0
+}
+
+// rgb returns the red, green and blue components of an extended color.
+func (c Color) rgb() (uint8, uint8, uint8) {
+	if c&colorFlagRGB != 0 {
+		return uint8(c >> 16), uint8(c >> 8), uint8(c)
+	}
+	return color256ToRGB(uint8(c))
+}
+
+// color256ToRGB returns the red, green and blue components of the color at the
+// given index in the 256-color palette (using the values xterm defaults to).
+func color256ToRGB(n uint8) (uint8, uint8, uint8) {
+	switch {
+	case n < 16:
+		rgb := color16RGB[n]
+		return rgb[0], rgb[1], rgb[2]
+	case n < 232:
+		n -= 16
+		return color256CubeLevels[n/36], color256CubeLevels[(n/6)%6], color256CubeLevels[n%6]
+	default:
+		gray := 8 + (n-232)*10
+		return gray, gray, gray
+	}
+}
+
+// rgbTo16 returns the index of the nearest color in the first 16 colors of the
+// 256-color palette.
+func rgbTo16(r, g, b uint8) uint8 {
+	var idx uint8
+	minDistance := -1
+	for colorIdx, rgb := range color16RGB {
+		if distance := rgbDistance(r, g, b, rgb[0], rgb[1], rgb[2]); minDistance < 0 || distance < minDistance {
+			idx, minDistance = uint8(colorIdx), distance
+		}
+	}
+	return idx
+}
+
+// rgbTo256 returns the index of the nearest color in the 6x6x6 color cube or
+// the grayscale ramp of the 256-color palette. The first 16 colors are never
+// used as they are usually customized by the terminal themes.
+func rgbTo256(r, g, b uint8) uint8 {
+	cubeIdx := func(value uint8) uint8 {
+		var idx uint8
+		for levelIdx, level := range color256CubeLevels {
+			if absDiff(value, level) < absDiff(value, color256CubeLevels[idx]) {
+				idx = uint8(levelIdx)
+			}
+		}
+		return idx
+	}
+	cr, cg, cb := cubeIdx(r), cubeIdx(g), cubeIdx(b)
+	cube := 16 + 36*cr + 6*cg + cb
+
+	grayIdx := (int(r) + int(g) + int(b)) / 3
+	if grayIdx < 8 {
+		grayIdx = 0
+	} else if grayIdx = (grayIdx - 8 + 5) / 10; grayIdx > 23 {
+		grayIdx = 23
+	}
+	gray := uint8(232 + grayIdx)
+
+	cubeR, cubeG, cubeB := color256ToRGB(cube)
+	grayR, grayG, grayB := color256ToRGB(gray)
+	if rgbDistance(r, g, b, grayR, grayG, grayB) < rgbDistance(r, g, b, cubeR, cubeG, cubeB) {
+		return gray
+	}
+	return cube
+}
+
+// rgbDistance returns the (squared) distance between two RGB colors.
+func rgbDistance(r1, g1, b1, r2, g2, b2 uint8) int {
+	dr, dg, db := int(r1)-int(r2), int(g1)-int(g2), int(b1)-int(b2)
+	return dr*dr + dg*dg + db*db
+}
+
+func absDiff(a, b uint8) uint8 {
+	if a > b {
+		return a - b
+	}
+	return b - a
+}
+
+// parseHexColor parses a color code in the "#rrggbb" or "#rgb" formats.
+func parseHexColor(hex string) (uint8, uint8, uint8, error) {
+	hexDigits := strings.TrimPrefix(hex, "#")
+	if len(hexDigits) == 3 {
+		hexDigits = string([]byte{
+			hexDigits[0], hexDigits[0], hexDigits[1], hexDigits[1], hexDigits[2], hexDigits[2],
+		})
+	}
+	if len(hexDigits) != 6 {
+		return 0, 0, 0, fmt.Errorf("invalid hex color code %#v", hex)
+	}
+	rgb, err := strconv.ParseUint(hexDigits, 16, 32)
+	if err != nil {
+		return 0, 0, 0, fmt.Errorf("invalid hex color code %#v", hex)
+	}
+	return uint8(rgb >> 16), uint8(rgb >> 8), uint8(rgb), nil
 }
 
 // Colors represents an array of Color objects to render with.
@@ -128,6 +480,22 @@ var (
 	colorsSeqMap = sync.Map{}
 )
 
+// Downgrade returns the nearest colors supported by the ColorProfile. Refer to
+// Color.Downgrade for details.
+func (c Colors) Downgrade(profile ColorProfile) Colors {
+	for idx, color := range c {
+		if colorDowngraded := color.Downgrade(profile); colorDowngraded != color {
+			out := make(Colors, len(c))
+			copy(out, c[:idx])
+			for ; idx < len(c); idx++ {
+				out[idx] = c[idx].Downgrade(profile)
+			}
+			return out
+		}
+	}
+	return c
+}
+
 // EscapeSeq returns the ANSI escape sequence for the colors set.
 func (c Colors) EscapeSeq() string {
 	if len(c) == 0 {
@@ -139,7 +507,7 @@ func (c Colors) EscapeSeq() string {
 	if !ok || escapeSeq == "" {
 		colorNums := make([]string, len(c))
 		for idx, color := range c {
-			colorNums[idx] = strconv.Itoa(int(color))
+			colorNums[idx] = color.code()
 		}
 		escapeSeq = EscapeStart + strings.Join(colorNums, ";") + EscapeStop
 		colorsSeqMap.Store(colorsKey, escapeSeq)
@@ -147,6 +515,39 @@ func (c Colors) EscapeSeq() string {
 	return escapeSeq.(string)
 }
 
+// CSSStyle returns the inline CSS declaration(s) for the colors. If more than
+// one color sets the same property, the last one wins, except for
+// "text-decoration" which can take multiple values. For ex.:
+//  Colors{Bold, FgRed}.CSSStyle() == "font-weight: bold; color: #cd0000"
+func (c Colors) CSSStyle() string {
+	if len(c) == 0 {
+		return ""
+	}
+
+	var properties []string
+	values := make(map[string]string)
+	for _, color := range c {
+		if property, value, ok := color.cssStyle(); ok {
+			existingValue, ok := values[property]
+			if !ok {
+				properties = append(properties, property)
+			} else if property == "text-decoration" {
+				if strings.Contains(existingValue, value) {
+					continue
+				}
+				value = existingValue + " " + value
+			}
+			values[property] = value
+		}
+	}
+
+	declarations := make([]string, len(properties))
+	for idx, property := range properties {
+		declarations[idx] = property + ": " + values[property]
+	}
+	return strings.Join(declarations, "; ")
+}
+
 // HTMLProperty returns the "class" attribute for the colors.
 func (c Colors) HTMLProperty() string {
 	if len(c) == 0 {
@@ -154,29 +555,69 @@ func (c Colors) HTMLProperty() string {
 	}
 
 	var classes []string
+	var colorsExtended Colors
 	for _, color := range c {
 		if class, ok := colorCSSClassMap[color]; ok {
 			classes = append(classes, class)
+		} else if color.isExtended() {
+			colorsExtended = append(colorsExtended, color)
 		}
 	}
 	if len(classes) > 1 {
 		sort.Strings(classes)
 	}
+
+	// extended colors do not have equivalent CSS-classes; use inline styles
+	if len(colorsExtended) > 0 {
+		style := fmt.Sprintf("style=\"%s\"", colorsExtended.CSSStyle())
+		if len(classes) == 0 {
+			return style
+		}
+		return fmt.Sprintf("class=\"%s\" %s", strings.Join(classes, " "), style)
+	}
 	return fmt.Sprintf("class=\"%s\"", strings.Join(classes, " "))
 }
 
 // Sprint colorizes and prints the given string(s).
 func (c Colors) Sprint(a ...interface{}) string {
-	return colorize(fmt.Sprint(a...), c.EscapeSeq())
+	return colorize(fmt.Sprint(a...), c.escapeSeq(GetColorProfile()))
 }
 
 // Sprintf formats and colorizes and prints the given string(s).
 func (c Colors) Sprintf(format string, a ...interface{}) string {
-	return colorize(fmt.Sprintf(format, a...), c.EscapeSeq())
+	return colorize(fmt.Sprintf(format, a...), c.escapeSeq(GetColorProfile()))
+}
+
+// SprintContext colorizes and prints the given string(s) using the
+// ColorProfile in the context (see WithColorProfile).
+func (c Colors) SprintContext(ctx context.Context, a ...interface{}) string {
+	return colorize(fmt.Sprint(a...), c.escapeSeq(ColorProfileFromContext(ctx)))
+}
+
+// SprintfContext formats and colorizes and prints the given string(s) using
+// the ColorProfile in the context (see WithColorProfile).
+func (c Colors) SprintfContext(ctx context.Context, format string, a ...interface{}) string {
+	return colorize(fmt.Sprintf(format, a...), c.escapeSeq(ColorProfileFromContext(ctx)))
+}
+
+// SprintProfile colorizes and prints the given string(s) for the given
+// ColorProfile instead of the global one; the colors are downgraded as needed,
+// and are not rendered at all for ColorProfileNone.
+func (c Colors) SprintProfile(profile ColorProfile, a ...interface{}) string {
+	return colorize(fmt.Sprint(a...), c.escapeSeq(profile))
+}
+
+// escapeSeq returns the ANSI escape sequence for the colors as supported by
+// the ColorProfile.
+func (c Colors) escapeSeq(profile ColorProfile) string {
+	if profile <= ColorProfileNone {
+		return ""
+	}
+	return c.Downgrade(profile).EscapeSeq()
 }
 
 func colorize(s string, escapeSeq string) string {
-	if !colorsEnabled || escapeSeq == "" {
+	if escapeSeq == "" {
 		return s
 	}
 	return Escape(s, escapeSeq)
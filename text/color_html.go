@@ -1,5 +1,10 @@
 package text
 
+import (
+	"sort"
+	"strings"
+)
+
 var (
 	// colorCSSClassMap contains the equivalent CSS-class for all colors
 	colorCSSClassMap = map[Color]string{
@@ -46,3 +51,117 @@ var (
 		BgHiWhite:    "bg-hi-white",
 	}
 )
+
+var (
+	// color16RGB contains the red, green and blue components of the first 16
+	// colors of the 256-color palette; the values are the defaults used by xterm
+	color16RGB = [16][3]uint8{
+		{0x00, 0x00, 0x00}, {0xcd, 0x00, 0x00}, {0x00, 0xcd, 0x00}, {0xcd, 0xcd, 0x00},
+		{0x00, 0x00, 0xee}, {0xcd, 0x00, 0xcd}, {0x00, 0xcd, 0xcd}, {0xe5, 0xe5, 0xe5},
+		{0x7f, 0x7f, 0x7f}, {0xff, 0x00, 0x00}, {0x00, 0xff, 0x00}, {0xff, 0xff, 0x00},
+		{0x5c, 0x5c, 0xff}, {0xff, 0x00, 0xff}, {0x00, 0xff, 0xff}, {0xff, 0xff, 0xff},
+	}
+
+	// color256CubeLevels contains the intensity levels used by the 6x6x6 color
+	// cube in the 256-color palette
+	color256CubeLevels = [6]uint8{0x00, 0x5f, 0x87, 0xaf, 0xd7, 0xff}
+)
+
+var (
+	// colorCSSStyleMap contains the equivalent inline CSS property and value
+	// for all colors; the color values are the defaults used by xterm
+	colorCSSStyleMap = map[Color][2]string{
+		Bold:         {"font-weight", "bold"},
+		Faint:        {"opacity", "0.5"},
+		Italic:       {"font-style", "italic"},
+		Underline:    {"text-decoration", "underline"},
+		BlinkSlow:    {"text-decoration", "blink"},
+		BlinkRapid:   {"text-decoration", "blink"},
+		ReverseVideo: {"filter", "invert(100%)"},
+		Concealed:    {"visibility", "hidden"},
+		CrossedOut:   {"text-decoration", "line-through"},
+		FgBlack:      {"color", "#000000"},
+		FgRed:        {"color", "#cd0000"},
+		FgGreen:      {"color", "#00cd00"},
+		FgYellow:     {"color", "#cdcd00"},
+		FgBlue:       {"color", "#0000ee"},
+		FgMagenta:    {"color", "#cd00cd"},
+		FgCyan:       {"color", "#00cdcd"},
+		FgWhite:      {"color", "#e5e5e5"},
+		FgHiBlack:    {"color", "#7f7f7f"},
+		FgHiRed:      {"color", "#ff0000"},
+		FgHiGreen:    {"color", "#00ff00"},
+		FgHiYellow:   {"color", "#ffff00"},
+		FgHiBlue:     {"color", "#5c5cff"},
+		FgHiMagenta:  {"color", "#ff00ff"},
+		FgHiCyan:     {"color", "#00ffff"},
+		FgHiWhite:    {"color", "#ffffff"},
+		BgBlack:      {"background-color", "#000000"},
+		BgRed:        {"background-color", "#cd0000"},
+		BgGreen:      {"background-color", "#00cd00"},
+		BgYellow:     {"background-color", "#cdcd00"},
+		BgBlue:       {"background-color", "#0000ee"},
+		BgMagenta:    {"background-color", "#cd00cd"},
+		BgCyan:       {"background-color", "#00cdcd"},
+		BgWhite:      {"background-color", "#e5e5e5"},
+		BgHiBlack:    {"background-color", "#7f7f7f"},
+		BgHiRed:      {"background-color", "#ff0000"},
+		BgHiGreen:    {"background-color", "#00ff00"},
+		BgHiYellow:   {"background-color", "#ffff00"},
+		BgHiBlue:     {"background-color", "#5c5cff"},
+		BgHiMagenta:  {"background-color", "#ff00ff"},
+		BgHiCyan:     {"background-color", "#00ffff"},
+		BgHiWhite:    {"background-color", "#ffffff"},
+	}
+)
+
+var (
+	// colorsByName contains all the colors by their names (as in
+	// colorCSSClassMap) stripped of dashes; used to look up colors by the CSS
+	// class or by the name of the constant (ex.: "fg-hi-red" and "FgHiRed")
+	colorsByName = func() map[string]Color {
+		colors := map[string]Color{"reset": Reset}
+		for color, class := range colorCSSClassMap {
+			colors[strings.Replace(class, "-", "", -1)] = color
+		}
+		return colors
+	}()
+)
+
+// GenerateCSS returns a stylesheet with the CSS classes used for the colors in
+// HTML output (see Color.HTMLProperty and Colors.HTMLProperty); include it in
+// the HTML page to get the colors rendered. For ex.:
+//  .bold { font-weight: bold; }
+//  .fg-red { color: #cd0000; }
+//  .bg-hi-white { background-color: #ffffff; }
+func GenerateCSS() string {
+	return GenerateCSSScoped("")
+}
+
+// GenerateCSSScoped returns the same stylesheet as GenerateCSS, but with every
+// CSS class scoped under the given selector so as not to affect the rest of
+// the page. For ex.:
+//  GenerateCSSScoped(".go-pretty-table") => ".go-pretty-table .fg-red { color: #cd0000; }\n..."
+func GenerateCSSScoped(scope string) string {
+	colors := make([]Color, 0, len(colorCSSClassMap))
+	for color := range colorCSSClassMap {
+		colors = append(colors, color)
+	}
+	sort.Slice(colors, func(i, j int) bool {
+		return colors[i] < colors[j]
+	})
+	if scope != "" {
+		scope += " "
+	}
+
+	var out strings.Builder
+	for _, color := range colors {
+		out.WriteString(scope)
+		out.WriteRune('.')
+		out.WriteString(colorCSSClassMap[color])
+		out.WriteString(" { ")
+		out.WriteString(color.CSSStyle())
+		out.WriteString("; }\n")
+	}
+	return out.String()
+}
@@ -0,0 +1,218 @@
+package text
+
+import (
+	"context"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// ColorProfile denotes the colors supported by an output (like a terminal).
+type ColorProfile int
+
+// colorProfileContextKey is the key for the ColorProfile in a context.Context.
+type colorProfileContextKey struct{}
+
+// Color Profiles
+const (
+	// ColorProfileNone supports no colors (or any other escape sequences)
+	ColorProfileNone ColorProfile = iota
+	// ColorProfileANSI supports the 16 basic (and high intensity) colors
+	ColorProfileANSI
+	// ColorProfileANSI256 supports the 256-color palette
+	ColorProfileANSI256
+	// ColorProfileTrueColor supports 24-bit (RGB) colors
+	ColorProfileTrueColor
+)
+
+// String returns the name of the ColorProfile.
+func (p ColorProfile) String() string {
+	switch p {
+	case ColorProfileNone:
+		return "none"
+	case ColorProfileANSI:
+		return "ansi"
+	case ColorProfileANSI256:
+		return "ansi256"
+	case ColorProfileTrueColor:
+		return "truecolor"
+	}
+	return "ColorProfile(" + strconv.Itoa(int(p)) + ")"
+}
+
+// Convert converts the color escape sequences in the string to the ones
+// supported by the ColorProfile. All escape sequences are stripped for
+// ColorProfileNone, and 256-color/RGB colors are downgraded to the nearest
+// supported color for ColorProfileANSI and ColorProfileANSI256. For ex.:
+//  ColorProfileNone.Convert("\x1b[91mGhost\x1b[0m") == "Ghost"
+//  ColorProfileANSI.Convert("\x1b[38;2;255;0;0mGhost\x1b[0m") == "\x1b[91mGhost\x1b[0m"
+//  ColorProfileANSI256.Convert("\x1b[38;2;255;0;0mGhost\x1b[0m") == "\x1b[38;5;196mGhost\x1b[0m"
+func (p ColorProfile) Convert(str string) string {
+	if p >= ColorProfileTrueColor || !strings.ContainsRune(str, EscapeStartRune) {
+		return str
+	}
+	if p <= ColorProfileNone {
+		return StripEscape(str)
+	}
+
+	var out strings.Builder
+	out.Grow(len(str))
+	var parser escSeqParser
+	for _, c := range str {
+		// write out the incomplete escape sequence that is being abandoned
+		if c == EscapeStartRune && parser.inEscSeq && !parser.isOSC {
+			out.WriteString(parser.seq.String())
+		}
+		if !parser.consume(c) {
+			out.WriteRune(c)
+		} else if escSeq := parser.completedCSI(); escSeq != "" {
+			out.WriteString(convertEscapeSeq(escSeq, p))
+		} else if parser.completed != "" {
+			out.WriteString(parser.completed)
+		}
+	}
+	if parser.inEscSeq {
+		out.WriteString(parser.seq.String())
+	}
+	return out.String()
+}
+
+// ColorProfileFromContext returns the ColorProfile set in the context using
+// WithColorProfile, or the global one (see GetColorProfile) if none was set.
+func ColorProfileFromContext(ctx context.Context) ColorProfile {
+	if ctx != nil {
+		if profile, ok := ctx.Value(colorProfileContextKey{}).(ColorProfile); ok {
+			return profile
+		}
+	}
+	return GetColorProfile()
+}
+
+// WithColorProfile returns a copy of the context with the ColorProfile set in
+// it. Use this with the SprintContext/SprintfContext functions of Color and
+// Colors to render colors for a specific output without having to change (or
+// race on) the global ColorProfile. For ex.:
+//  ctx := WithColorProfile(context.Background(), DetectColorProfile(logFile))
+//  fmt.Fprintln(logFile, FgRed.SprintContext(ctx, "error!"))
+func WithColorProfile(ctx context.Context, profile ColorProfile) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return context.WithValue(ctx, colorProfileContextKey{}, profile)
+}
+
+// DetectColorProfile returns the ColorProfile supported by the given output
+// using the following rules (in order):
+//   * "NO_COLOR" environment variable set => ColorProfileNone
+//   * "FORCE_COLOR" environment variable set => "0"/"false" for
+//     ColorProfileNone, "2" for ColorProfileANSI256, "3" for
+//     ColorProfileTrueColor, and ColorProfileANSI for anything else
+//   * output is not a terminal => ColorProfileNone
+//   * "TERM" environment variable is "dumb" => ColorProfileNone
+//   * "COLORTERM" environment variable is "truecolor"/"24bit" (or running
+//     in Windows Terminal) => ColorProfileTrueColor
+//   * "TERM" environment variable contains "256color" => ColorProfileANSI256
+//   * everything else => ColorProfileANSI
+func DetectColorProfile(w io.Writer) ColorProfile {
+	return detectColorProfile(isTerminal(w), os.LookupEnv)
+}
+
+func detectColorProfile(isTerminal bool, lookupEnv func(string) (string, bool)) ColorProfile {
+	getEnv := func(key string) string {
+		value, _ := lookupEnv(key)
+		return value
+	}
+
+	if getEnv("NO_COLOR") != "" {
+		return ColorProfileNone
+	}
+	if forceColor, ok := lookupEnv("FORCE_COLOR"); ok {
+		switch strings.ToLower(forceColor) {
+		case "0", "false":
+			return ColorProfileNone
+		case "2":
+			return ColorProfileANSI256
+		case "3":
+			return ColorProfileTrueColor
+		}
+		return ColorProfileANSI
+	}
+	if !isTerminal {
+		return ColorProfileNone
+	}
+
+	term := strings.ToLower(getEnv("TERM"))
+	if term == "dumb" {
+		return ColorProfileNone
+	}
+	if colorTerm := strings.ToLower(getEnv("COLORTERM")); colorTerm == "truecolor" || colorTerm == "24bit" {
+		return ColorProfileTrueColor
+	}
+	if getEnv("WT_SESSION") != "" {
+		return ColorProfileTrueColor
+	}
+	if strings.Contains(term, "256color") {
+		return ColorProfileANSI256
+	}
+	return ColorProfileANSI
+}
+
+// convertEscapeSeq converts the colors in the SGR escape sequence to the ones
+// supported by the ColorProfile.
+func convertEscapeSeq(escSeq string, p ColorProfile) string {
+	if !strings.HasSuffix(escSeq, EscapeStop) {
+		return escSeq
+	}
+	params := strings.Split(escSeq[len(EscapeStart):len(escSeq)-len(EscapeStop)], ";")
+
+	codes := make([]string, 0, len(params))
+	for idx := 0; idx < len(params); idx++ {
+		color, numParams := parseExtendedColor(params[idx:])
+		if numParams == 0 {
+			codes = append(codes, params[idx])
+			continue
+		}
+		codes = append(codes, color.Downgrade(p).code())
+		idx += numParams - 1
+	}
+	return EscapeStart + strings.Join(codes, ";") + EscapeStop
+}
+
+// parseExtendedColor parses the 256-color ("38;5;n") or the RGB
+// ("38;2;r;g;b") color at the start of the SGR parameters, and returns the
+// Color and the number of parameters it occupies (0 if there is none).
+func parseExtendedColor(params []string) (Color, int) {
+	if len(params) < 3 || (params[0] != "38" && params[0] != "48") {
+		return Reset, 0
+	}
+
+	var values [3]uint8
+	numValues := 1
+	if params[1] == "2" {
+		numValues = 3
+	} else if params[1] != "5" {
+		return Reset, 0
+	}
+	if len(params) < 2+numValues {
+		return Reset, 0
+	}
+	for idx := 0; idx < numValues; idx++ {
+		value, err := strconv.ParseUint(params[2+idx], 10, 8)
+		if err != nil {
+			return Reset, 0
+		}
+		values[idx] = uint8(value)
+	}
+
+	var color Color
+	if numValues == 1 {
+		color = Fg256(values[0])
+	} else {
+		color = FgRGB(values[0], values[1], values[2])
+	}
+	if params[0] == "48" {
+		color |= colorFlagBg
+	}
+	return color, 2 + numValues
+}
@@ -0,0 +1,167 @@
+package text
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func ExampleColorProfile_Convert() {
+	str := FgRGB(255, 0, 0).Sprint("Ghost")
+	fmt.Printf("ColorProfileNone.Convert(%#v) == %#v\n", str, ColorProfileNone.Convert(str))
+	fmt.Printf("ColorProfileANSI.Convert(%#v) == %#v\n", str, ColorProfileANSI.Convert(str))
+	fmt.Printf("ColorProfileANSI256.Convert(%#v) == %#v\n", str, ColorProfileANSI256.Convert(str))
+	fmt.Printf("ColorProfileTrueColor.Convert(%#v) == %#v\n", str, ColorProfileTrueColor.Convert(str))
+
+	// Output: ColorProfileNone.Convert("\x1b[38;2;255;0;0mGhost\x1b[0m") == "Ghost"
+	// ColorProfileANSI.Convert("\x1b[38;2;255;0;0mGhost\x1b[0m") == "\x1b[91mGhost\x1b[0m"
+	// ColorProfileANSI256.Convert("\x1b[38;2;255;0;0mGhost\x1b[0m") == "\x1b[38;5;196mGhost\x1b[0m"
+	// ColorProfileTrueColor.Convert("\x1b[38;2;255;0;0mGhost\x1b[0m") == "\x1b[38;2;255;0;0mGhost\x1b[0m"
+}
+
+func TestColorProfile_Convert(t *testing.T) {
+	assert.Equal(t, "Ghost", ColorProfileANSI.Convert("Ghost"))
+	assert.Equal(t, "GhostLady", ColorProfileNone.Convert(FgRed.Sprint("Ghost")+Hyperlink("https://github.com", "Lady")))
+
+	// basic colors and other parameters are left untouched
+	assert.Equal(t, "\x1b[1;31mGhost\x1b[0m", ColorProfileANSI.Convert("\x1b[1;31mGhost\x1b[0m"))
+	assert.Equal(t, "\x1b[1;91;44mGhost\x1b[0m", ColorProfileANSI.Convert("\x1b[1;38;2;255;0;0;48;5;4mGhost\x1b[0m"))
+	assert.Equal(t, "\x1b[1;38;5;196;48;5;4mGhost\x1b[0m", ColorProfileANSI256.Convert("\x1b[1;38;2;255;0;0;48;5;4mGhost\x1b[0m"))
+
+	// hyperlinks and malformed sequences are left untouched
+	hyperlink := Hyperlink("https://github.com", "Ghost")
+	assert.Equal(t, hyperlink, ColorProfileANSI.Convert(hyperlink))
+	assert.Equal(t, "\x1b[38;5mGhost\x1b[0m", ColorProfileANSI.Convert("\x1b[38;5mGhost\x1b[0m"))
+	assert.Equal(t, "\x1b[38;2;256;0;0mGhost", ColorProfileANSI.Convert("\x1b[38;2;256;0;0mGhost"))
+	assert.Equal(t, "Ghost\x1b[38;5", ColorProfileANSI.Convert("Ghost\x1b[38;5"))
+}
+
+func TestColorProfile_String(t *testing.T) {
+	assert.Equal(t, "none", ColorProfileNone.String())
+	assert.Equal(t, "ansi", ColorProfileANSI.String())
+	assert.Equal(t, "ansi256", ColorProfileANSI256.String())
+	assert.Equal(t, "truecolor", ColorProfileTrueColor.String())
+	assert.Equal(t, "ColorProfile(9)", ColorProfile(9).String())
+}
+
+func TestDetectColorProfile(t *testing.T) {
+	assert.Equal(t, ColorProfileNone, DetectColorProfile(nil))
+
+	f, err := ioutil.TempFile("", "go-pretty-text-")
+	if assert.Nil(t, err) {
+		defer os.Remove(f.Name())
+		defer f.Close()
+		if os.Getenv("NO_COLOR") == "" && os.Getenv("FORCE_COLOR") == "" {
+			assert.Equal(t, ColorProfileNone, DetectColorProfile(f))
+		}
+	}
+
+	testCases := []struct {
+		isTerminal bool
+		env        map[string]string
+		expected   ColorProfile
+	}{
+		{false, nil, ColorProfileNone},
+		{true, nil, ColorProfileANSI},
+		{true, map[string]string{"NO_COLOR": "1"}, ColorProfileNone},
+		{true, map[string]string{"NO_COLOR": "1", "FORCE_COLOR": "3"}, ColorProfileNone},
+		{false, map[string]string{"FORCE_COLOR": ""}, ColorProfileANSI},
+		{false, map[string]string{"FORCE_COLOR": "1"}, ColorProfileANSI},
+		{false, map[string]string{"FORCE_COLOR": "true"}, ColorProfileANSI},
+		{false, map[string]string{"FORCE_COLOR": "2"}, ColorProfileANSI256},
+		{false, map[string]string{"FORCE_COLOR": "3"}, ColorProfileTrueColor},
+		{true, map[string]string{"FORCE_COLOR": "0"}, ColorProfileNone},
+		{true, map[string]string{"FORCE_COLOR": "FALSE"}, ColorProfileNone},
+		{true, map[string]string{"TERM": "dumb", "COLORTERM": "truecolor"}, ColorProfileNone},
+		{true, map[string]string{"TERM": "xterm", "COLORTERM": "truecolor"}, ColorProfileTrueColor},
+		{true, map[string]string{"TERM": "xterm", "COLORTERM": "24bit"}, ColorProfileTrueColor},
+		{true, map[string]string{"WT_SESSION": "foo"}, ColorProfileTrueColor},
+		{true, map[string]string{"TERM": "xterm-256color"}, ColorProfileANSI256},
+		{true, map[string]string{"TERM": "screen-256color", "COLORTERM": "1"}, ColorProfileANSI256},
+		{true, map[string]string{"TERM": "xterm"}, ColorProfileANSI},
+	}
+	for _, tc := range testCases {
+		lookupEnv := func(key string) (string, bool) {
+			value, ok := tc.env[key]
+			return value, ok
+		}
+		assert.Equal(t, tc.expected, detectColorProfile(tc.isTerminal, lookupEnv), "%v %v", tc.isTerminal, tc.env)
+	}
+}
+
+func TestColorProfileFromContext(t *testing.T) {
+	defer EnableColors()
+	DisableColors()
+
+	assert.Equal(t, ColorProfileNone, ColorProfileFromContext(nil))
+	assert.Equal(t, ColorProfileNone, ColorProfileFromContext(context.Background()))
+
+	ctx := WithColorProfile(context.Background(), ColorProfileANSI256)
+	assert.Equal(t, ColorProfileANSI256, ColorProfileFromContext(ctx))
+	assert.Equal(t, ColorProfileANSI, ColorProfileFromContext(WithColorProfile(ctx, ColorProfileANSI)))
+	assert.Equal(t, ColorProfileTrueColor, ColorProfileFromContext(WithColorProfile(nil, ColorProfileTrueColor)))
+
+	SetColorProfile(ColorProfileANSI)
+	assert.Equal(t, ColorProfileANSI, ColorProfileFromContext(context.Background()))
+	assert.Equal(t, ColorProfileANSI256, ColorProfileFromContext(ctx))
+}
+
+func TestColorProfile_Concurrency(t *testing.T) {
+	defer EnableColors()
+
+	ctxNone := WithColorProfile(context.Background(), ColorProfileNone)
+	ctxANSI := WithColorProfile(context.Background(), ColorProfileANSI)
+	ctxTrueColor := WithColorProfile(context.Background(), ColorProfileTrueColor)
+	colors := Colors{Bold, FgRGB(255, 0, 0)}
+
+	var wg sync.WaitGroup
+	wg.Add(4)
+	go func() {
+		defer wg.Done()
+		for idx := 0; idx < 100; idx++ {
+			if idx%2 == 0 {
+				DisableColors()
+			} else {
+				EnableColors()
+			}
+			_ = FgRed.Sprint("test")
+			_ = Hyperlink("https://github.com", "test")
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for idx := 0; idx < 100; idx++ {
+			assert.Equal(t, "test", colors.SprintContext(ctxNone, "test"))
+			assert.Equal(t, "test", FgRed.SprintfContext(ctxNone, "%s", "test"))
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for idx := 0; idx < 100; idx++ {
+			assert.Equal(t, "\x1b[1;91mtest\x1b[0m", colors.SprintfContext(ctxANSI, "%s", "test"))
+			assert.Equal(t, "\x1b[91mtest\x1b[0m", FgRGB(255, 0, 0).SprintContext(ctxANSI, "test"))
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for idx := 0; idx < 100; idx++ {
+			assert.Equal(t, "\x1b[1;38;2;255;0;0mtest\x1b[0m", colors.SprintContext(ctxTrueColor, "test"))
+			assert.Equal(t, "\x1b[1;38;2;255;0;0mtest\x1b[0m", colors.SprintProfile(ColorProfileTrueColor, "test"))
+		}
+	}()
+	wg.Wait()
+}
+
+func ExampleWithColorProfile() {
+	ctx := WithColorProfile(context.Background(), ColorProfileANSI)
+	fmt.Printf("%#v\n", FgRGB(255, 0, 0).SprintContext(ctx, "Ghost"))
+	fmt.Printf("%#v\n", FgRGB(255, 0, 0).SprintContext(WithColorProfile(ctx, ColorProfileNone), "Ghost"))
+
+	// Output: "\x1b[91mGhost\x1b[0m"
+	// "Ghost"
+}
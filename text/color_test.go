@@ -1,7 +1,9 @@
 package text
 
 import (
+	"encoding/json"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -24,6 +26,95 @@ func TestColor_EnableAndDisable(t *testing.T) {
 	assert.Equal(t, "\x1b[31mtest\x1b[0m", FgRed.Sprint("test"))
 }
 
+func ExampleFg256() {
+	fmt.Printf("Orange Foreground: %#v\n", Fg256(208).EscapeSeq())
+	fmt.Printf("Orange Background: %#v\n", Bg256(208).EscapeSeq())
+
+	// Output: Orange Foreground: "\x1b[38;5;208m"
+	// Orange Background: "\x1b[48;5;208m"
+}
+
+func TestFg256(t *testing.T) {
+	assert.Equal(t, "\x1b[38;5;0m", Fg256(0).EscapeSeq())
+	assert.Equal(t, "\x1b[38;5;255m", Fg256(255).EscapeSeq())
+	assert.Equal(t, "\x1b[48;5;0m", Bg256(0).EscapeSeq())
+	assert.Equal(t, "\x1b[48;5;255m", Bg256(255).EscapeSeq())
+	assert.NotEqual(t, Fg256(0), Bg256(0))
+	assert.NotEqual(t, Fg256(0), Reset)
+}
+
+func ExampleFgRGB() {
+	fmt.Printf("Orange Foreground: %#v\n", FgRGB(255, 136, 0).EscapeSeq())
+	fmt.Printf("Orange Background: %#v\n", BgRGB(255, 136, 0).EscapeSeq())
+
+	// Output: Orange Foreground: "\x1b[38;2;255;136;0m"
+	// Orange Background: "\x1b[48;2;255;136;0m"
+}
+
+func TestFgRGB(t *testing.T) {
+	assert.Equal(t, "\x1b[38;2;0;0;0m", FgRGB(0, 0, 0).EscapeSeq())
+	assert.Equal(t, "\x1b[38;2;1;2;3m", FgRGB(1, 2, 3).EscapeSeq())
+	assert.Equal(t, "\x1b[48;2;255;255;255m", BgRGB(255, 255, 255).EscapeSeq())
+	assert.NotEqual(t, FgRGB(0, 0, 0), BgRGB(0, 0, 0))
+	assert.NotEqual(t, FgRGB(0, 0, 0), Fg256(0))
+}
+
+func ExampleFgHex() {
+	color, err := FgHex("#ff8800")
+	fmt.Printf("FgHex(\"#ff8800\"): %#v, %v\n", color.EscapeSeq(), err)
+	color, err = BgHex("f80")
+	fmt.Printf("BgHex(\"f80\"): %#v, %v\n", color.EscapeSeq(), err)
+	_, err = FgHex("orange")
+	fmt.Printf("FgHex(\"orange\"): %v\n", err)
+
+	// Output: FgHex("#ff8800"): "\x1b[38;2;255;136;0m", <nil>
+	// BgHex("f80"): "\x1b[48;2;255;136;0m", <nil>
+	// FgHex("orange"): invalid hex color code "orange"
+}
+
+func TestFgHex(t *testing.T) {
+	for _, hex := range []string{"#ff8800", "ff8800", "#FF8800", "#f80", "F80"} {
+		color, err := FgHex(hex)
+		assert.Nil(t, err, hex)
+		assert.Equal(t, FgRGB(255, 136, 0), color, hex)
+
+		color, err = BgHex(hex)
+		assert.Nil(t, err, hex)
+		assert.Equal(t, BgRGB(255, 136, 0), color, hex)
+	}
+	for _, hex := range []string{"", "#", "#ff88", "#ff880000", "#gg8800", "#-f8800"} {
+		_, err := FgHex(hex)
+		assert.NotNil(t, err, hex)
+		_, err = BgHex(hex)
+		assert.NotNil(t, err, hex)
+	}
+}
+
+func TestColor256ToRGB(t *testing.T) {
+	assertRGB := func(expected [3]uint8, n uint8) {
+		r, g, b := color256ToRGB(n)
+		assert.Equal(t, expected, [3]uint8{r, g, b}, n)
+	}
+
+	assertRGB([3]uint8{0x00, 0x00, 0x00}, 0)
+	assertRGB([3]uint8{0xcd, 0x00, 0x00}, 1)
+	assertRGB([3]uint8{0xff, 0xff, 0xff}, 15)
+	assertRGB([3]uint8{0x00, 0x00, 0x00}, 16)
+	assertRGB([3]uint8{0x00, 0x00, 0x5f}, 17)
+	assertRGB([3]uint8{0xff, 0x87, 0x00}, 208)
+	assertRGB([3]uint8{0xff, 0xff, 0xff}, 231)
+	assertRGB([3]uint8{0x08, 0x08, 0x08}, 232)
+	assertRGB([3]uint8{0xee, 0xee, 0xee}, 255)
+
+	// the first 16 colors should match the CSS colors of the basic colors
+	for idx := uint8(0); idx < 8; idx++ {
+		r, g, b := color256ToRGB(idx)
+		assert.Equal(t, fmt.Sprintf("color: #%02x%02x%02x", r, g, b), (FgBlack + Color(idx)).CSSStyle())
+		r, g, b = color256ToRGB(idx + 8)
+		assert.Equal(t, fmt.Sprintf("color: #%02x%02x%02x", r, g, b), (FgHiBlack + Color(idx)).CSSStyle())
+	}
+}
+
 func ExampleColor_EscapeSeq() {
 	fmt.Printf("Black Background: %#v\n", BgBlack.EscapeSeq())
 	fmt.Printf("Black Foreground: %#v\n", FgBlack.EscapeSeq())
@@ -36,6 +127,27 @@ func TestColor_EscapeSeq(t *testing.T) {
 	assert.Equal(t, "\x1b[40m", BgBlack.EscapeSeq())
 }
 
+func ExampleColor_CSSStyle() {
+	fmt.Printf("Bold: %#v\n", Bold.CSSStyle())
+	fmt.Printf("Black Background: %#v\n", BgBlack.CSSStyle())
+	fmt.Printf("Red Foreground: %#v\n", FgRed.CSSStyle())
+
+	// Output: Bold: "font-weight: bold"
+	// Black Background: "background-color: #000000"
+	// Red Foreground: "color: #cd0000"
+}
+
+func TestColor_CSSStyle(t *testing.T) {
+	assert.Equal(t, "font-weight: bold", Bold.CSSStyle())
+	assert.Equal(t, "background-color: #000000", BgBlack.CSSStyle())
+	assert.Equal(t, "color: #ffffff", FgHiWhite.CSSStyle())
+	assert.Equal(t, "", Reset.CSSStyle())
+	assert.Equal(t, "color: #ff8700", Fg256(208).CSSStyle())
+	assert.Equal(t, "background-color: #eeeeee", Bg256(255).CSSStyle())
+	assert.Equal(t, "color: #ff8800", FgRGB(255, 136, 0).CSSStyle())
+	assert.Equal(t, "background-color: #010203", BgRGB(1, 2, 3).CSSStyle())
+}
+
 func ExampleColor_HTMLProperty() {
 	fmt.Printf("Bold: %#v\n", Bold.HTMLProperty())
 	fmt.Printf("Black Background: %#v\n", BgBlack.HTMLProperty())
@@ -46,10 +158,39 @@ func ExampleColor_HTMLProperty() {
 	// Black Foreground: "class=\"fg-black\""
 }
 
+func TestGenerateCSS(t *testing.T) {
+	css := GenerateCSS()
+	lines := strings.Split(strings.TrimSuffix(css, "\n"), "\n")
+	assert.Len(t, lines, len(colorCSSClassMap))
+	assert.Equal(t, ".bold { font-weight: bold; }", lines[0])
+	assert.Contains(t, lines, ".fg-red { color: #cd0000; }")
+	assert.Contains(t, lines, ".bg-hi-blue { background-color: #5c5cff; }")
+	assert.Equal(t, ".bg-hi-white { background-color: #ffffff; }", lines[len(lines)-1])
+
+	// every CSS class used in HTMLProperty should be defined in the stylesheet
+	for color, class := range colorCSSClassMap {
+		assert.Contains(t, color.HTMLProperty(), class)
+		assert.Contains(t, css, "."+class+" { ")
+	}
+}
+
+func TestGenerateCSSScoped(t *testing.T) {
+	css := GenerateCSSScoped(".report")
+	lines := strings.Split(strings.TrimSuffix(css, "\n"), "\n")
+	assert.Len(t, lines, len(colorCSSClassMap))
+	assert.Equal(t, ".report .bold { font-weight: bold; }", lines[0])
+	assert.Contains(t, lines, ".report .fg-red { color: #cd0000; }")
+
+	assert.Equal(t, GenerateCSS(), GenerateCSSScoped(""))
+}
+
 func TestColor_HTMLProperty(t *testing.T) {
 	assert.Equal(t, "class=\"bold\"", Bold.HTMLProperty())
 	assert.Equal(t, "class=\"bg-black\"", BgBlack.HTMLProperty())
 	assert.Equal(t, "class=\"fg-black\"", FgBlack.HTMLProperty())
+	assert.Equal(t, "style=\"color: #ff8700\"", Fg256(208).HTMLProperty())
+	assert.Equal(t, "style=\"background-color: #ff8800\"", BgRGB(255, 136, 0).HTMLProperty())
+	assert.Equal(t, "", Reset.HTMLProperty())
 }
 
 func ExampleColor_Sprint() {
@@ -96,6 +237,24 @@ func ExampleColors_EscapeSeq() {
 func TestColors_EscapeSeq(t *testing.T) {
 	assert.Equal(t, "", Colors{}.EscapeSeq())
 	assert.Equal(t, "\x1b[40;37m", Colors{BgBlack, FgWhite}.EscapeSeq())
+	assert.Equal(t, "\x1b[1;38;5;208;48;2;1;2;3m", Colors{Bold, Fg256(208), BgRGB(1, 2, 3)}.EscapeSeq())
+	assert.Equal(t, "\x1b[1;38;5;208;48;2;1;2;3m", Colors{Bold, Fg256(208), BgRGB(1, 2, 3)}.EscapeSeq())
+	assert.Equal(t, "\x1b[38;5;209m", Colors{Fg256(209)}.EscapeSeq())
+}
+
+func ExampleColors_CSSStyle() {
+	fmt.Printf("Black Background, White Foreground: %#v\n", Colors{BgBlack, FgWhite}.CSSStyle())
+	fmt.Printf("Bold Italic Underline Red Text: %#v\n", Colors{Bold, Italic, Underline, FgRed}.CSSStyle())
+
+	// Output: Black Background, White Foreground: "background-color: #000000; color: #e5e5e5"
+	// Bold Italic Underline Red Text: "font-weight: bold; font-style: italic; text-decoration: underline; color: #cd0000"
+}
+
+func TestColors_CSSStyle(t *testing.T) {
+	assert.Equal(t, "", Colors{}.CSSStyle())
+	assert.Equal(t, "color: #cd0000", Colors{FgGreen, FgRed}.CSSStyle())
+	assert.Equal(t, "text-decoration: underline line-through", Colors{Underline, CrossedOut, Underline}.CSSStyle())
+	assert.Equal(t, "font-weight: bold; color: #ff8700; background-color: #010203", Colors{Bold, Fg256(208), BgRGB(1, 2, 3)}.CSSStyle())
 }
 
 func ExampleColors_HTMLProperty() {
@@ -116,6 +275,8 @@ func TestColors_HTMLProperty(t *testing.T) {
 	assert.Equal(t, "", Colors{}.HTMLProperty())
 	assert.Equal(t, "class=\"bg-black fg-white\"", Colors{BgBlack, FgWhite}.HTMLProperty())
 	assert.Equal(t, "class=\"bold fg-red\"", Colors{Bold, FgRed}.HTMLProperty())
+	assert.Equal(t, "style=\"color: #ff8700\"", Colors{Fg256(208)}.HTMLProperty())
+	assert.Equal(t, "class=\"bold\" style=\"color: #ff8700; background-color: #010203\"", Colors{Bold, Fg256(208), BgRGB(1, 2, 3)}.HTMLProperty())
 }
 
 func ExampleColors_Sprint() {
@@ -156,3 +317,144 @@ func TestColors_Sprintf(t *testing.T) {
 	assert.Equal(t, "test true", Colors{}.Sprintf("test %s", "true"))
 	assert.Equal(t, "\x1b[31mtest true\x1b[0m", Colors{FgRed}.Sprintf("test %s", "true"))
 }
+
+func TestColor_Downgrade(t *testing.T) {
+	// non-extended colors are never downgraded
+	assert.Equal(t, FgRed, FgRed.Downgrade(ColorProfileANSI))
+	assert.Equal(t, Bold, Bold.Downgrade(ColorProfileANSI))
+
+	// nothing to do for these profiles
+	assert.Equal(t, FgRGB(255, 0, 0), FgRGB(255, 0, 0).Downgrade(ColorProfileTrueColor))
+	assert.Equal(t, FgRGB(255, 0, 0), FgRGB(255, 0, 0).Downgrade(ColorProfileNone))
+
+	// 256-color palette
+	assert.Equal(t, Fg256(208), Fg256(208).Downgrade(ColorProfileANSI256))
+	assert.Equal(t, Fg256(196), FgRGB(255, 0, 0).Downgrade(ColorProfileANSI256))
+	assert.Equal(t, Bg256(196), BgRGB(255, 0, 0).Downgrade(ColorProfileANSI256))
+	assert.Equal(t, Fg256(208), FgRGB(255, 135, 0).Downgrade(ColorProfileANSI256))
+	assert.Equal(t, Fg256(16), FgRGB(0, 0, 0).Downgrade(ColorProfileANSI256))
+	assert.Equal(t, Fg256(231), FgRGB(255, 255, 255).Downgrade(ColorProfileANSI256))
+	assert.Equal(t, Fg256(244), FgRGB(128, 128, 128).Downgrade(ColorProfileANSI256))
+	assert.Equal(t, Fg256(235), FgRGB(0x26, 0x26, 0x26).Downgrade(ColorProfileANSI256))
+
+	// 16 colors
+	assert.Equal(t, FgRed, Fg256(1).Downgrade(ColorProfileANSI))
+	assert.Equal(t, FgHiRed, Fg256(9).Downgrade(ColorProfileANSI))
+	assert.Equal(t, BgHiWhite, Bg256(15).Downgrade(ColorProfileANSI))
+	assert.Equal(t, FgHiRed, Fg256(196).Downgrade(ColorProfileANSI))
+	assert.Equal(t, FgHiRed, FgRGB(255, 0, 0).Downgrade(ColorProfileANSI))
+	assert.Equal(t, BgHiRed, BgRGB(255, 0, 0).Downgrade(ColorProfileANSI))
+	assert.Equal(t, FgBlue, FgRGB(0, 0, 200).Downgrade(ColorProfileANSI))
+	assert.Equal(t, FgBlack, FgRGB(10, 10, 10).Downgrade(ColorProfileANSI))
+	assert.Equal(t, BgHiBlack, Bg256(244).Downgrade(ColorProfileANSI))
+}
+
+func TestColors_Downgrade(t *testing.T) {
+	colors := Colors{Bold, FgRed}
+	assert.Equal(t, colors, colors.Downgrade(ColorProfileANSI))
+
+	colors = Colors{Bold, FgRGB(255, 0, 0), BgRGB(0, 0, 200)}
+	assert.Equal(t, Colors{Bold, FgHiRed, BgBlue}, colors.Downgrade(ColorProfileANSI))
+	assert.Equal(t, Colors{Bold, Fg256(196), Bg256(20)}, colors.Downgrade(ColorProfileANSI256))
+	assert.Equal(t, colors, colors.Downgrade(ColorProfileTrueColor))
+	assert.Equal(t, Colors{Bold, FgRGB(255, 0, 0), BgRGB(0, 0, 200)}, colors, "should not modify the original")
+}
+
+func TestColors_SprintProfile(t *testing.T) {
+	defer EnableColors()
+	DisableColors()
+
+	colors := Colors{Bold, FgRGB(255, 0, 0)}
+	assert.Equal(t, "test", colors.Sprint("test"))
+	assert.Equal(t, "test", colors.SprintProfile(ColorProfileNone, "test"))
+	assert.Equal(t, "\x1b[1;91mtest\x1b[0m", colors.SprintProfile(ColorProfileANSI, "test"))
+	assert.Equal(t, "\x1b[1;38;5;196mtest\x1b[0m", colors.SprintProfile(ColorProfileANSI256, "test"))
+	assert.Equal(t, "\x1b[1;38;2;255;0;0mtest\x1b[0m", colors.SprintProfile(ColorProfileTrueColor, "test"))
+	assert.Equal(t, "test", Colors{}.SprintProfile(ColorProfileTrueColor, "test"))
+}
+
+func TestSetColorProfile(t *testing.T) {
+	defer EnableColors()
+
+	SetColorProfile(ColorProfileANSI256)
+	assert.Equal(t, ColorProfileANSI256, GetColorProfile())
+	assert.Equal(t, "\x1b[38;5;196mtest\x1b[0m", FgRGB(255, 0, 0).Sprint("test"))
+	assert.Equal(t, "\x1b[38;5;196mtest\x1b[0m", Colors{FgRGB(255, 0, 0)}.Sprintf("%s", "test"))
+
+	SetColorProfile(ColorProfileANSI)
+	assert.Equal(t, ColorProfileANSI, GetColorProfile())
+	assert.Equal(t, "\x1b[91mtest\x1b[0m", FgRGB(255, 0, 0).Sprintf("%s", "test"))
+	assert.Equal(t, "\x1b[31mtest\x1b[0m", FgRed.Sprint("test"))
+
+	DisableColors()
+	assert.Equal(t, ColorProfileNone, GetColorProfile())
+	assert.Equal(t, "test", FgRGB(255, 0, 0).Sprint("test"))
+
+	EnableColors()
+	assert.Equal(t, ColorProfileTrueColor, GetColorProfile())
+	assert.Equal(t, "\x1b[38;2;255;0;0mtest\x1b[0m", FgRGB(255, 0, 0).Sprint("test"))
+}
+
+func TestColor_Name(t *testing.T) {
+	for color, expected := range map[Color]string{
+		Reset:               "reset",
+		Bold:                "bold",
+		FgHiRed:             "fg-hi-red",
+		BgBlue:              "bg-blue",
+		Fg256(208):          "fg-256-208",
+		Bg256(0):            "bg-256-0",
+		FgRGB(255, 136, 0):  "fg-#ff8800",
+		BgRGB(0, 0, 0):      "bg-#000000",
+		BgRGB(10, 200, 255): "bg-#0ac8ff",
+	} {
+		assert.Equal(t, expected, color.Name())
+
+		colorByName, err := ColorByName(color.Name())
+		assert.Nil(t, err)
+		assert.Equal(t, color, colorByName)
+	}
+
+	assert.Equal(t, "", Color(12345).Name())
+}
+
+func TestColorByName(t *testing.T) {
+	for name, expected := range map[string]Color{
+		"FgHiRed":     FgHiRed,
+		"fg_hi_red":   FgHiRed,
+		" BG-BLUE ":   BgBlue,
+		"CrossedOut":  CrossedOut,
+		"FG-256-208":  Fg256(208),
+		"fg-#F80":     FgRGB(255, 136, 0),
+		"bg-#0ac8ff":  BgRGB(10, 200, 255),
+		"Reset":       Reset,
+		"bg-hi-white": BgHiWhite,
+	} {
+		color, err := ColorByName(name)
+		assert.Nil(t, err, name)
+		assert.Equal(t, expected, color, name)
+	}
+
+	for _, name := range []string{"", "red", "fg-256-256", "fg-256-", "fg-#12345", "fg-#xyz", "hi-red"} {
+		_, err := ColorByName(name)
+		assert.NotNil(t, err, name)
+		assert.Equal(t, fmt.Sprintf("invalid color %#v", name), err.Error())
+	}
+}
+
+func TestColors_JSON(t *testing.T) {
+	colors := Colors{Bold, FgHiRed, Bg256(17), FgRGB(255, 136, 0)}
+
+	// colors are encoded as their numeric values (refer to Color.Name for
+	// their names)
+	data, err := json.Marshal(colors)
+	assert.Nil(t, err)
+	assert.Equal(t, fmt.Sprintf(`[1,91,%d,%d]`, int(Bg256(17)), int(FgRGB(255, 136, 0))), string(data))
+
+	var colorsUnmarshaled Colors
+	assert.Nil(t, json.Unmarshal(data, &colorsUnmarshaled))
+	assert.Equal(t, colors, colorsUnmarshaled)
+
+	data, err = json.Marshal(Color(12345))
+	assert.Nil(t, err)
+	assert.Equal(t, "12345", string(data))
+}
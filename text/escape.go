@@ -0,0 +1,62 @@
+package text
+
+import "strings"
+
+// escSeqParser helps determine if the runes of a string (being iterated upon
+// one rune at a time) are part of an escape sequence. It understands:
+//   * CSI sequences (ex.: "\x1b[91m") that are terminated by 'm'
+//   * OSC sequences (ex.: "\x1b]8;;url\x1b\\") that are terminated by ST
+//     ("\x1b\\") or BEL ("\a")
+// It also keeps track of the currently open OSC 8 hyperlink if any.
+type escSeqParser struct {
+	// completed is the escape sequence completed by the last rune consumed
+	completed string
+	// hyperlink is the OSC 8 escape sequence that opened the hyperlink that is
+	// yet to be closed
+	hyperlink string
+	inEscSeq  bool
+	isOSC     bool
+	seq       strings.Builder
+}
+
+// consume processes the rune and returns true if it is part of an escape
+// sequence.
+func (p *escSeqParser) consume(r rune) bool {
+	p.completed = ""
+	if r == EscapeStartRune && !(p.inEscSeq && p.isOSC) {
+		p.inEscSeq, p.isOSC = true, false
+		p.seq.Reset()
+	}
+	if !p.inEscSeq {
+		return false
+	}
+
+	p.seq.WriteRune(r)
+	if p.seq.Len() == len(escapeStartOSC) && r == ']' {
+		p.isOSC = true
+	}
+	if p.isOSC && (r == '\a' || (r == '\\' && strings.HasSuffix(p.seq.String(), escapeStopOSC))) {
+		p.inEscSeq = false
+		p.completed = p.seq.String()
+		if strings.HasPrefix(p.completed, escapeStartHyperlink) {
+			if url, _ := parseHyperlink(p.completed); url != "" {
+				p.hyperlink = p.completed
+			} else {
+				p.hyperlink = ""
+			}
+		}
+	} else if !p.isOSC && r == EscapeStopRune {
+		p.inEscSeq = false
+		p.completed = p.seq.String()
+	}
+	return true
+}
+
+// completedCSI returns the CSI escape sequence (ex.: colors) completed by the
+// last rune consumed, or an empty string if the last rune did not complete one.
+func (p *escSeqParser) completedCSI() string {
+	if strings.HasPrefix(p.completed, EscapeStart) {
+		return p.completed
+	}
+	return ""
+}
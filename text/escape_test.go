@@ -0,0 +1,41 @@
+package text
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEscSeqParser(t *testing.T) {
+	parse := func(str string) []bool {
+		var parser escSeqParser
+		var rsp []bool
+		for _, c := range str {
+			rsp = append(rsp, parser.consume(c))
+		}
+		return rsp
+	}
+
+	assert.Equal(t, []bool{false, true, true, true, true, false}, parse("a\x1b[1mb"))
+	assert.Equal(t, []bool{true, true, true, true, true, true, true, true, false}, parse("\x1b]8;;m\x1b\\b"))
+	assert.Equal(t, []bool{true, true, true, true, true, true, true, false}, parse("\x1b]8;;m\ab"))
+	assert.Equal(t, []bool{true, true, true, true, true, true, true, false}, parse("\x1b[3\x1b[1mb"))
+
+	var parser escSeqParser
+	for _, c := range "\x1b[1m\x1b]8;;url\x1b\\" {
+		parser.consume(c)
+		if c == 'm' {
+			assert.Equal(t, "\x1b[1m", parser.completed)
+			assert.Equal(t, "\x1b[1m", parser.completedCSI())
+		}
+	}
+	assert.Equal(t, "\x1b]8;;url\x1b\\", parser.completed)
+	assert.Equal(t, "", parser.completedCSI())
+	assert.Equal(t, "\x1b]8;;url\x1b\\", parser.hyperlink)
+	assert.False(t, parser.inEscSeq)
+
+	for _, c := range "label\x1b]8;;\x1b\\" {
+		parser.consume(c)
+	}
+	assert.Equal(t, "", parser.hyperlink)
+}
@@ -21,7 +21,7 @@ const (
 func (tc Format) Apply(text string) string {
 	switch tc {
 	case FormatLower:
-		return strings.ToLower(text)
+		return toLower(text)
 	case FormatTitle:
 		return toTitle(text)
 	case FormatUpper:
@@ -31,14 +31,25 @@ func (tc Format) Apply(text string) string {
 	}
 }
 
-func toTitle(text string) string {
-	prev, inEscSeq := ' ', false
+func toLower(text string) string {
+	var parser escSeqParser
 	return strings.Map(
 		func(r rune) rune {
-			if r == EscapeStartRune {
-				inEscSeq = true
+			if !parser.consume(r) {
+				r = unicode.ToLower(r)
 			}
-			if !inEscSeq {
+			return r
+		},
+		text,
+	)
+}
+
+func toTitle(text string) string {
+	var parser escSeqParser
+	prev := ' '
+	return strings.Map(
+		func(r rune) rune {
+			if !parser.consume(r) {
 				if isSeparator(prev) {
 					prev = r
 					r = unicode.ToUpper(r)
@@ -46,9 +57,6 @@ func toTitle(text string) string {
 					prev = r
 				}
 			}
-			if inEscSeq && r == EscapeStopRune {
-				inEscSeq = false
-			}
 			return r
 		},
 		text,
@@ -56,18 +64,12 @@ func toTitle(text string) string {
 }
 
 func toUpper(text string) string {
-	inEscSeq := false
+	var parser escSeqParser
 	return strings.Map(
 		func(r rune) rune {
-			if r == EscapeStartRune {
-				inEscSeq = true
-			}
-			if !inEscSeq {
+			if !parser.consume(r) {
 				r = unicode.ToUpper(r)
 			}
-			if inEscSeq && r == EscapeStopRune {
-				inEscSeq = false
-			}
 			return r
 		},
 		text,
@@ -42,4 +42,10 @@ func TestFormat_Apply(t *testing.T) {
 	assert.Equal(t, "\x1b[1ma big croc0dile; died - empty_fanged ツ \u2008.\x1b[0m", FormatLower.Apply(text))
 	assert.Equal(t, "\x1b[1mA Big Croc0dile; Died - Empty_fanged ツ \u2008.\x1b[0m", FormatTitle.Apply(text))
 	assert.Equal(t, "\x1b[1mA BIG CROC0DILE; DIED - EMPTY_FANGED ツ \u2008.\x1b[0m", FormatUpper.Apply(text))
+
+	// test with hyperlinks
+	text = Hyperlink("https://example.com/Mixed", "Mixed Case")
+	assert.Equal(t, "\x1b]8;;https://example.com/Mixed\x1b\\mixed case\x1b]8;;\x1b\\", FormatLower.Apply(text))
+	assert.Equal(t, "\x1b]8;;https://example.com/Mixed\x1b\\Mixed Case\x1b]8;;\x1b\\", FormatTitle.Apply(text))
+	assert.Equal(t, "\x1b]8;;https://example.com/Mixed\x1b\\MIXED CASE\x1b]8;;\x1b\\", FormatUpper.Apply(text))
 }
@@ -0,0 +1,119 @@
+package text
+
+import "strings"
+
+// Constants
+const (
+	escapeStartOSC       = "\x1b]"
+	escapeStartHyperlink = escapeStartOSC + "8;"
+	escapeStopOSC        = "\x1b\\"
+	escapeStopOSCBell    = "\a"
+	hyperlinkEnd         = escapeStartHyperlink + ";" + escapeStopOSC
+)
+
+// Hyperlink returns the label as a clickable hyperlink to the URL using the
+// OSC 8 escape sequence understood by most modern terminals. The label is
+// rendered as-is by terminals that do not support them. The URL is used as the
+// label if the label is empty. For ex.:
+//  Hyperlink("https://github.com", "GitHub") == "\x1b]8;;https://github.com\x1b\\GitHub\x1b]8;;\x1b\\"
+func Hyperlink(url string, label string) string {
+	if label == "" {
+		label = url
+	}
+	if GetColorProfile() <= ColorProfileNone || url == "" {
+		return label
+	}
+	return escapeStartHyperlink + ";" + url + escapeStopOSC + label + hyperlinkEnd
+}
+
+// ReplaceHyperlinks replaces all the OSC 8 hyperlinks in the string with the
+// output of the given function, which gets called with the URL and the label
+// of each hyperlink. This is useful for converting them into other formats
+// like HTML. For ex.:
+//  ReplaceHyperlinks(Hyperlink("https://github.com", "GitHub"), func(url, label string) string {
+//    return "[" + label + "](" + url + ")"
+//  }) == "[GitHub](https://github.com)"
+func ReplaceHyperlinks(str string, replacer func(url string, label string) string) string {
+	if !strings.Contains(str, escapeStartHyperlink) {
+		return str
+	}
+
+	var out, label strings.Builder
+	var parser escSeqParser
+	curr, url := &out, ""
+	for _, c := range str {
+		// write out the incomplete escape sequence that is being abandoned
+		if c == EscapeStartRune && parser.inEscSeq && !parser.isOSC {
+			curr.WriteString(parser.seq.String())
+		}
+		if !parser.consume(c) {
+			curr.WriteRune(c)
+		} else if strings.HasPrefix(parser.completed, escapeStartHyperlink) {
+			if url != "" {
+				out.WriteString(replacer(url, label.String()))
+				label.Reset()
+			}
+			if url, _ = parseHyperlink(parser.completed); url != "" {
+				curr = &label
+			} else {
+				curr = &out
+			}
+		} else if parser.completed != "" {
+			curr.WriteString(parser.completed)
+		}
+	}
+	if parser.inEscSeq {
+		curr.WriteString(parser.seq.String())
+	}
+	if url != "" {
+		out.WriteString(replacer(url, label.String()))
+	}
+	return out.String()
+}
+
+// HTMLHyperlink returns an HTML anchor to the URL with the given label, and is
+// meant to be used with ReplaceHyperlinks to convert the hyperlinks in the
+// text being rendered as HTML. Only http, https and mailto URLs get linked (to
+// keep out the likes of "javascript:" URLs); the label is returned as is for
+// the rest. For ex.:
+//  HTMLHyperlink("https://github.com", "GitHub") == "<a href=\"https://github.com\">GitHub</a>"
+//  HTMLHyperlink("javascript:alert(1)", "GitHub") == "GitHub"
+func HTMLHyperlink(url string, label string) string {
+	if !isSafeHyperlinkURL(url) {
+		return label
+	}
+	return "<a href=\"" + strings.Replace(url, "\"", "&#34;", -1) + "\">" + label + "</a>"
+}
+
+// splitEscSeq splits the open escape sequences (as tracked by the wrap
+// functions) into the color escape sequence and the hyperlink escape sequence.
+func splitEscSeq(openEscSeq string) (string, string) {
+	if idx := strings.Index(openEscSeq, escapeStartHyperlink); idx >= 0 {
+		return openEscSeq[:idx], openEscSeq[idx:]
+	}
+	return openEscSeq, ""
+}
+
+// parseHyperlink returns the URL and the parameters in the OSC 8 escape
+// sequence; ex.: "\x1b]8;id=1;https://github.com\x1b\\" => "https://github.com",
+// "id=1".
+func parseHyperlink(escSeq string) (string, string) {
+	escSeq = strings.TrimPrefix(escSeq, escapeStartHyperlink)
+	escSeq = strings.TrimSuffix(strings.TrimSuffix(escSeq, escapeStopOSC), escapeStopOSCBell)
+	if idx := strings.Index(escSeq, ";"); idx >= 0 {
+		return escSeq[idx+1:], escSeq[:idx]
+	}
+	return "", escSeq
+}
+
+// isSafeHyperlinkURL returns true if the URL uses one of the schemes that are
+// safe to link to from HTML.
+func isSafeHyperlinkURL(url string) bool {
+	url = strings.ToLower(url)
+	for _, scheme := range []string{"http://", "https://", "mailto:"} {
+		if strings.HasPrefix(url, scheme) {
+			return true
+		}
+	}
+	return false
+}
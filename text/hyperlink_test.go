@@ -0,0 +1,91 @@
+package text
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func ExampleHyperlink() {
+	fmt.Printf("%#v\n", Hyperlink("https://github.com", "GitHub"))
+	fmt.Printf("%#v\n", Hyperlink("https://github.com", ""))
+
+	// Output: "\x1b]8;;https://github.com\x1b\\GitHub\x1b]8;;\x1b\\"
+	// "\x1b]8;;https://github.com\x1b\\https://github.com\x1b]8;;\x1b\\"
+}
+
+func TestHyperlink(t *testing.T) {
+	defer EnableColors()
+
+	assert.Equal(t, "\x1b]8;;https://github.com\x1b\\GitHub\x1b]8;;\x1b\\", Hyperlink("https://github.com", "GitHub"))
+	assert.Equal(t, "GitHub", Hyperlink("", "GitHub"))
+	assert.Equal(t, 6, RuneCount(Hyperlink("https://github.com", "GitHub")))
+
+	DisableColors()
+	assert.Equal(t, "GitHub", Hyperlink("https://github.com", "GitHub"))
+}
+
+func ExampleReplaceHyperlinks() {
+	str := "Visit " + Hyperlink("https://github.com", "GitHub") + "!"
+	fmt.Println(ReplaceHyperlinks(str, func(url string, label string) string {
+		return "[" + label + "](" + url + ")"
+	}))
+
+	// Output: Visit [GitHub](https://github.com)!
+}
+
+func TestReplaceHyperlinks(t *testing.T) {
+	replacer := func(url string, label string) string {
+		return "<a href=\"" + url + "\">" + label + "</a>"
+	}
+
+	assert.Equal(t, "Ghost", ReplaceHyperlinks("Ghost", replacer))
+	assert.Equal(t,
+		"a <a href=\"u1\">l1</a> b <a href=\"u2\">l2</a>",
+		ReplaceHyperlinks("a "+Hyperlink("u1", "l1")+" b "+Hyperlink("u2", "l2"), replacer))
+	assert.Equal(t,
+		"a \x1b[31m<a href=\"u1\">\x1b[1ml1\x1b[0m</a>\x1b[0m",
+		ReplaceHyperlinks("a \x1b[31m"+Hyperlink("u1", "\x1b[1ml1\x1b[0m")+"\x1b[0m", replacer))
+	assert.Equal(t,
+		"<a href=\"u1\">l1</a><a href=\"u2\">l2</a>",
+		ReplaceHyperlinks("\x1b]8;;u1\al1\x1b]8;id=2;u2\x1b\\l2", replacer))
+	assert.Equal(t,
+		"<a href=\"u1\">l1\x1b[3</a>",
+		ReplaceHyperlinks("\x1b]8;;u1\al1\x1b[3", replacer))
+	assert.Equal(t,
+		"\x1b]0;title\aGhost",
+		ReplaceHyperlinks("\x1b]0;title\aGhost\x1b]8;;\x1b\\", replacer))
+}
+
+func ExampleHTMLHyperlink() {
+	fmt.Println(ReplaceHyperlinks("Visit "+Hyperlink("https://github.com", "GitHub")+"!", HTMLHyperlink))
+
+	// Output: Visit <a href="https://github.com">GitHub</a>!
+}
+
+func TestHTMLHyperlink(t *testing.T) {
+	assert.Equal(t, "<a href=\"https://github.com\">GitHub</a>", HTMLHyperlink("https://github.com", "GitHub"))
+	assert.Equal(t, "<a href=\"HTTP://github.com\">GitHub</a>", HTMLHyperlink("HTTP://github.com", "GitHub"))
+	assert.Equal(t, "<a href=\"http://a.b/?q=&#34;x&#34;\">GitHub</a>", HTMLHyperlink("http://a.b/?q=\"x\"", "GitHub"))
+	assert.Equal(t, "<a href=\"mailto:ghost@lady.com\">Mail</a>", HTMLHyperlink("mailto:ghost@lady.com", "Mail"))
+	assert.Equal(t, "GitHub", HTMLHyperlink("javascript:alert(1)", "GitHub"))
+	assert.Equal(t, "GitHub", HTMLHyperlink("JavaScript:alert(1)", "GitHub"))
+	assert.Equal(t, "GitHub", HTMLHyperlink(" javascript:alert(1)", "GitHub"))
+	assert.Equal(t, "GitHub", HTMLHyperlink("data:text/html,<script>alert(1)</script>", "GitHub"))
+	assert.Equal(t, "GitHub", HTMLHyperlink("/relative/path", "GitHub"))
+}
+
+func TestParseHyperlink(t *testing.T) {
+	url, params := parseHyperlink("\x1b]8;id=1;https://github.com\x1b\\")
+	assert.Equal(t, "https://github.com", url)
+	assert.Equal(t, "id=1", params)
+
+	url, params = parseHyperlink("\x1b]8;;https://github.com\a")
+	assert.Equal(t, "https://github.com", url)
+	assert.Equal(t, "", params)
+
+	url, params = parseHyperlink("\x1b]8;;\x1b\\")
+	assert.Equal(t, "", url)
+	assert.Equal(t, "", params)
+}
@@ -0,0 +1,326 @@
+package text
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Defaults for the ImageOptions left unset.
+const (
+	DefaultImageFontFamily = "monospace"
+	DefaultImageFontSize   = 14
+	DefaultImageScale      = 2
+)
+
+// ImageOptions defines the options to control the rendering of text into
+// images (see ANSIToSVG and ANSIToImage). The zero-value of every option (other
+// than Padding) means that the default gets used.
+type ImageOptions struct {
+	Background Color  // color of the background; defaults to black (BgBlack)
+	Foreground Color  // color of text without a color; defaults to white (FgWhite)
+	FontFamily string // font-family of the text in SVGs; defaults to "monospace"
+	FontSize   int    // font-size (in px) of the text in SVGs; defaults to 14
+	Padding    int    // padding (in characters) around the text
+	Scale      int    // scale of the bitmap font used for raster images; defaults to 2 (12x20 px per character)
+}
+
+// ANSIToSVG renders the text (with the colors defined by the escape sequences
+// in it) as an SVG image of a terminal window using a monospace font, with
+// every line of text being one line in the image. For ex.:
+//  ANSIToSVG("\x1b[91mGhost\x1b[0m", ImageOptions{}) ==
+//  <svg xmlns="http://www.w3.org/2000/svg" width="42" height="16.8" viewBox="0 0 42 16.8">
+//  <rect width="100%" height="100%" fill="#000000"/>
+//  <g font-family="monospace" font-size="14" xml:space="preserve">
+//  <text x="0" y="14" fill="#ff0000" textLength="42" lengthAdjust="spacingAndGlyphs">Ghost</text>
+//  </g>
+//  </svg>
+func ANSIToSVG(str string, options ImageOptions) string {
+	bg, _ := options.colors()
+	fontFamily, fontSize := options.FontFamily, options.FontSize
+	if fontFamily == "" {
+		fontFamily = DefaultImageFontFamily
+	}
+	if fontSize <= 0 {
+		fontSize = DefaultImageFontSize
+	}
+	charWidth, lineHeight := float64(fontSize)*0.6, float64(fontSize)*1.2
+
+	lines := imageParse(str, options)
+	numCols, numLines := imageDimensions(lines, options.Padding)
+	width, height := svgNumber(float64(numCols)*charWidth), svgNumber(float64(numLines)*lineHeight)
+
+	var out strings.Builder
+	out.WriteString("<svg xmlns=\"http://www.w3.org/2000/svg\" width=\"" + width + "\" height=\"" + height + "\"")
+	out.WriteString(" viewBox=\"0 0 " + width + " " + height + "\">\n")
+	out.WriteString("<rect width=\"100%\" height=\"100%\" fill=\"" + svgColor(bg) + "\"/>\n")
+	out.WriteString("<g font-family=\"" + svgEscape(fontFamily) + "\" font-size=\"" + strconv.Itoa(fontSize) + "\" xml:space=\"preserve\">\n")
+	for lineIdx, line := range lines {
+		top := float64(lineIdx+options.Padding) * lineHeight
+		for _, segment := range imageSegments(line) {
+			x := svgNumber(float64(segment.col+options.Padding) * charWidth)
+			segmentWidth := svgNumber(float64(len(segment.cells)) * charWidth)
+			if segment.style.hasBg {
+				out.WriteString("<rect x=\"" + x + "\" y=\"" + svgNumber(top) + "\" width=\"" + segmentWidth)
+				out.WriteString("\" height=\"" + svgNumber(lineHeight) + "\" fill=\"" + svgColor(segment.style.bg) + "\"/>\n")
+			}
+			if content := segment.text(); strings.TrimSpace(content) != "" || segment.style.underline || segment.style.crossedOut {
+				out.WriteString("<text x=\"" + x + "\" y=\"" + svgNumber(top+float64(fontSize)) + "\" fill=\"" + svgColor(segment.style.fg) + "\"")
+				out.WriteString(segment.style.svgAttributes())
+				out.WriteString(" textLength=\"" + segmentWidth + "\" lengthAdjust=\"spacingAndGlyphs\">")
+				out.WriteString(svgEscape(content))
+				out.WriteString("</text>\n")
+			}
+		}
+	}
+	out.WriteString("</g>\n</svg>")
+	return out.String()
+}
+
+// ANSIToImage renders the text (with the colors defined by the escape
+// sequences in it) as a raster image of a terminal window using the bundled
+// 5x8 bitmap font, with every line of text being one line in the image. The
+// bundled font covers the printable ASCII characters, the box-drawing and block
+// characters used by the ready-to-use Styles, and a few more; other characters
+// are drawn as a hollow box. Encode the image using image/png to get a PNG.
+func ANSIToImage(str string, options ImageOptions) *image.RGBA {
+	bg, _ := options.colors()
+	scale := options.Scale
+	if scale <= 0 {
+		scale = DefaultImageScale
+	}
+	cellWidth, cellHeight := fontCellWidth*scale, fontCellHeight*scale
+
+	lines := imageParse(str, options)
+	numCols, numLines := imageDimensions(lines, options.Padding)
+	img := image.NewRGBA(image.Rect(0, 0, numCols*cellWidth, numLines*cellHeight))
+	fillRect(img, 0, 0, img.Bounds().Dx(), img.Bounds().Dy(), bg)
+	for lineIdx, line := range lines {
+		y := (lineIdx + options.Padding) * cellHeight
+		for colIdx, cell := range line {
+			x := (colIdx + options.Padding) * cellWidth
+			if cell.style.hasBg {
+				fillRect(img, x, y, cellWidth, cellHeight, cell.style.bg)
+			}
+			fontDrawRune(img, x, y, scale, cell.r, cell.style.fg, cell.style.bold)
+			if cell.style.underline {
+				fillRect(img, x, y+(fontCellHeight-1)*scale, cellWidth, scale, cell.style.fg)
+			}
+			if cell.style.crossedOut {
+				fillRect(img, x, y+(fontCellHeight/2)*scale, cellWidth, scale, cell.style.fg)
+			}
+		}
+	}
+	return img
+}
+
+// colors returns the background and foreground colors to use for the text.
+func (o ImageOptions) colors() (color.RGBA, color.RGBA) {
+	bg, ok := o.Background.rgba()
+	if !ok {
+		bg, _ = BgBlack.rgba()
+	}
+	fg, ok := o.Foreground.rgba()
+	if !ok {
+		fg, _ = FgWhite.rgba()
+	}
+	return bg, fg
+}
+
+// rgba returns the RGB value of the (foreground/background) color, and false
+// if the Color is not one (like Bold).
+func (c Color) rgba() (color.RGBA, bool) {
+	var r, g, b uint8
+	switch {
+	case c.isExtended():
+		r, g, b = c.rgb()
+	case c >= FgBlack && c <= FgWhite:
+		r, g, b = color256ToRGB(uint8(c - FgBlack))
+	case c >= FgHiBlack && c <= FgHiWhite:
+		r, g, b = color256ToRGB(uint8(c-FgHiBlack) + 8)
+	case c >= BgBlack && c <= BgWhite:
+		r, g, b = color256ToRGB(uint8(c - BgBlack))
+	case c >= BgHiBlack && c <= BgHiWhite:
+		r, g, b = color256ToRGB(uint8(c-BgHiBlack) + 8)
+	default:
+		return color.RGBA{}, false
+	}
+	return color.RGBA{R: r, G: g, B: b, A: 0xff}, true
+}
+
+// imageCell is a single character cell in an image of text.
+type imageCell struct {
+	r     rune // zero for the cells occupied by the preceding wide character
+	style imageStyle
+}
+
+// imageStyle is the look of a character cell resolved from the SGR state.
+type imageStyle struct {
+	bg         color.RGBA
+	fg         color.RGBA
+	hasBg      bool
+	bold       bool
+	crossedOut bool
+	italic     bool
+	underline  bool
+}
+
+// imageStyle resolves the colors in effect into the look of a character cell.
+func (s sgrState) imageStyle(options ImageOptions) imageStyle {
+	defaultBg, defaultFg := options.colors()
+	style := imageStyle{bg: defaultBg, fg: defaultFg}
+	if s.hasFg {
+		if fg, ok := s.fg.rgba(); ok {
+			style.fg = fg
+		}
+	}
+	if s.hasBg {
+		if bg, ok := s.bg.rgba(); ok {
+			style.bg, style.hasBg = bg, true
+		}
+	}
+
+	var concealed, faint, reverse bool
+	for _, attribute := range s.attributes {
+		switch attribute {
+		case Bold:
+			style.bold = true
+		case Faint:
+			faint = true
+		case Italic:
+			style.italic = true
+		case Underline:
+			style.underline = true
+		case ReverseVideo:
+			reverse = true
+		case Concealed:
+			concealed = true
+		case CrossedOut:
+			style.crossedOut = true
+		}
+	}
+	if reverse {
+		style.bg, style.fg, style.hasBg = style.fg, style.bg, true
+	}
+	if faint {
+		style.fg = color.RGBA{
+			R: uint8((int(style.fg.R) + int(style.bg.R)) / 2),
+			G: uint8((int(style.fg.G) + int(style.bg.G)) / 2),
+			B: uint8((int(style.fg.B) + int(style.bg.B)) / 2),
+			A: 0xff,
+		}
+	}
+	if concealed {
+		style.fg = style.bg
+	}
+	return style
+}
+
+// svgAttributes returns the SVG attributes for the font-weight, font-style and
+// text-decoration of the text.
+func (s imageStyle) svgAttributes() string {
+	var attributes strings.Builder
+	if s.bold {
+		attributes.WriteString(" font-weight=\"bold\"")
+	}
+	if s.italic {
+		attributes.WriteString(" font-style=\"italic\"")
+	}
+	if s.underline && s.crossedOut {
+		attributes.WriteString(" text-decoration=\"underline line-through\"")
+	} else if s.underline {
+		attributes.WriteString(" text-decoration=\"underline\"")
+	} else if s.crossedOut {
+		attributes.WriteString(" text-decoration=\"line-through\"")
+	}
+	return attributes.String()
+}
+
+// imageSegment is a run of consecutive character cells with the same look.
+type imageSegment struct {
+	cells []imageCell
+	col   int
+	style imageStyle
+}
+
+// text returns the characters in the segment.
+func (s imageSegment) text() string {
+	var out strings.Builder
+	for _, cell := range s.cells {
+		if cell.r != 0 {
+			out.WriteRune(cell.r)
+		}
+	}
+	return out.String()
+}
+
+// imageDimensions returns the number of columns and lines of character cells
+// needed to render the lines with the given padding.
+func imageDimensions(lines [][]imageCell, padding int) (int, int) {
+	numCols := 0
+	for _, line := range lines {
+		if len(line) > numCols {
+			numCols = len(line)
+		}
+	}
+	return numCols + padding*2, len(lines) + padding*2
+}
+
+// imageParse splits the text into lines of character cells, with the colors
+// defined by the SGR escape sequences resolved into the look of every cell.
+// Other escape sequences (like hyperlinks) are dropped.
+func imageParse(str string, options ImageOptions) [][]imageCell {
+	var parser escSeqParser
+	var state sgrState
+	style := state.imageStyle(options)
+	lines := [][]imageCell{nil}
+	for _, c := range str {
+		if !parser.consume(c) {
+			lineIdx := len(lines) - 1
+			if c == '\n' {
+				lines = append(lines, nil)
+			} else if width := RuneWidth(c); width > 0 {
+				lines[lineIdx] = append(lines[lineIdx], imageCell{r: c, style: style})
+				for ; width > 1; width-- {
+					lines[lineIdx] = append(lines[lineIdx], imageCell{style: style})
+				}
+			}
+		} else if escSeq := parser.completedCSI(); escSeq != "" {
+			state.apply(escSeq)
+			style = state.imageStyle(options)
+		}
+	}
+	return lines
+}
+
+// imageSegments splits the line into runs of character cells with the same
+// look.
+func imageSegments(line []imageCell) []imageSegment {
+	var segments []imageSegment
+	for colIdx, cell := range line {
+		if len(segments) == 0 || segments[len(segments)-1].style != cell.style {
+			segments = append(segments, imageSegment{col: colIdx, style: cell.style})
+		}
+		segment := &segments[len(segments)-1]
+		segment.cells = append(segment.cells, cell)
+	}
+	return segments
+}
+
+// svgColor returns the color in the "#rrggbb" format.
+func svgColor(c color.RGBA) string {
+	return fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B)
+}
+
+// svgEscape escapes the text for use in SVG content and attribute values.
+func svgEscape(str string) string {
+	return strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", "\"", "&quot;").Replace(str)
+}
+
+// svgNumber returns the number rounded to 2 decimal places.
+func svgNumber(n float64) string {
+	return strconv.FormatFloat(math.Round(n*100)/100, 'f', -1, 64)
+}
@@ -0,0 +1,220 @@
+package text
+
+import (
+	"image"
+	"image/color"
+)
+
+// Dimensions (in font-pixels) of every character cell in images rendered
+// using the bundled bitmap font; the glyphs themselves are 5x8 font-pixels
+// and leave the rest of the cell as spacing.
+const (
+	fontCellHeight = 10
+	fontCellWidth  = 6
+	fontGlyphTop   = 1
+)
+
+// Weights of the lines in box-drawing characters.
+const (
+	boxLineNone = iota
+	boxLineLight
+	boxLineHeavy
+	boxLineDouble
+)
+
+var (
+	// fontGlyphsASCII contains the 5x8 bitmaps for the printable ASCII
+	// characters (' ' to '~'); each byte is a column of the glyph with the
+	// least significant bit being the top-most pixel
+	fontGlyphsASCII = [95][5]byte{
+		{0x00, 0x00, 0x00, 0x00, 0x00}, {0x00, 0x00, 0x5f, 0x00, 0x00}, // ' ', '!'
+		{0x00, 0x07, 0x00, 0x07, 0x00}, {0x14, 0x7f, 0x14, 0x7f, 0x14}, // '"', '#'
+		{0x24, 0x2a, 0x7f, 0x2a, 0x12}, {0x23, 0x13, 0x08, 0x64, 0x62}, // '$', '%'
+		{0x36, 0x49, 0x55, 0x22, 0x50}, {0x00, 0x05, 0x03, 0x00, 0x00}, // '&', '''
+		{0x00, 0x1c, 0x22, 0x41, 0x00}, {0x00, 0x41, 0x22, 0x1c, 0x00}, // '(', ')'
+		{0x14, 0x08, 0x3e, 0x08, 0x14}, {0x08, 0x08, 0x3e, 0x08, 0x08}, // '*', '+'
+		{0x00, 0x50, 0x30, 0x00, 0x00}, {0x08, 0x08, 0x08, 0x08, 0x08}, // ',', '-'
+		{0x00, 0x60, 0x60, 0x00, 0x00}, {0x20, 0x10, 0x08, 0x04, 0x02}, // '.', '/'
+		{0x3e, 0x51, 0x49, 0x45, 0x3e}, {0x00, 0x42, 0x7f, 0x40, 0x00}, // '0', '1'
+		{0x42, 0x61, 0x51, 0x49, 0x46}, {0x21, 0x41, 0x45, 0x4b, 0x31}, // '2', '3'
+		{0x18, 0x14, 0x12, 0x7f, 0x10}, {0x27, 0x45, 0x45, 0x45, 0x39}, // '4', '5'
+		{0x3c, 0x4a, 0x49, 0x49, 0x30}, {0x01, 0x71, 0x09, 0x05, 0x03}, // '6', '7'
+		{0x36, 0x49, 0x49, 0x49, 0x36}, {0x06, 0x49, 0x49, 0x29, 0x1e}, // '8', '9'
+		{0x00, 0x36, 0x36, 0x00, 0x00}, {0x00, 0x56, 0x36, 0x00, 0x00}, // ':', ';'
+		{0x08, 0x14, 0x22, 0x41, 0x00}, {0x14, 0x14, 0x14, 0x14, 0x14}, // '<', '='
+		{0x00, 0x41, 0x22, 0x14, 0x08}, {0x02, 0x01, 0x51, 0x09, 0x06}, // '>', '?'
+		{0x32, 0x49, 0x79, 0x41, 0x3e}, {0x7e, 0x11, 0x11, 0x11, 0x7e}, // '@', 'A'
+		{0x7f, 0x49, 0x49, 0x49, 0x36}, {0x3e, 0x41, 0x41, 0x41, 0x22}, // 'B', 'C'
+		{0x7f, 0x41, 0x41, 0x22, 0x1c}, {0x7f, 0x49, 0x49, 0x49, 0x41}, // 'D', 'E'
+		{0x7f, 0x09, 0x09, 0x09, 0x01}, {0x3e, 0x41, 0x49, 0x49, 0x7a}, // 'F', 'G'
+		{0x7f, 0x08, 0x08, 0x08, 0x7f}, {0x00, 0x41, 0x7f, 0x41, 0x00}, // 'H', 'I'
+		{0x20, 0x40, 0x41, 0x3f, 0x01}, {0x7f, 0x08, 0x14, 0x22, 0x41}, // 'J', 'K'
+		{0x7f, 0x40, 0x40, 0x40, 0x40}, {0x7f, 0x02, 0x0c, 0x02, 0x7f}, // 'L', 'M'
+		{0x7f, 0x04, 0x08, 0x10, 0x7f}, {0x3e, 0x41, 0x41, 0x41, 0x3e}, // 'N', 'O'
+		{0x7f, 0x09, 0x09, 0x09, 0x06}, {0x3e, 0x41, 0x51, 0x21, 0x5e}, // 'P', 'Q'
+		{0x7f, 0x09, 0x19, 0x29, 0x46}, {0x46, 0x49, 0x49, 0x49, 0x31}, // 'R', 'S'
+		{0x01, 0x01, 0x7f, 0x01, 0x01}, {0x3f, 0x40, 0x40, 0x40, 0x3f}, // 'T', 'U'
+		{0x1f, 0x20, 0x40, 0x20, 0x1f}, {0x3f, 0x40, 0x38, 0x40, 0x3f}, // 'V', 'W'
+		{0x63, 0x14, 0x08, 0x14, 0x63}, {0x07, 0x08, 0x70, 0x08, 0x07}, // 'X', 'Y'
+		{0x61, 0x51, 0x49, 0x45, 0x43}, {0x00, 0x7f, 0x41, 0x41, 0x00}, // 'Z', '['
+		{0x02, 0x04, 0x08, 0x10, 0x20}, {0x00, 0x41, 0x41, 0x7f, 0x00}, // '\', ']'
+		{0x04, 0x02, 0x01, 0x02, 0x04}, {0x40, 0x40, 0x40, 0x40, 0x40}, // '^', '_'
+		{0x00, 0x01, 0x02, 0x04, 0x00}, {0x20, 0x54, 0x54, 0x54, 0x78}, // '`', 'a'
+		{0x7f, 0x48, 0x44, 0x44, 0x38}, {0x38, 0x44, 0x44, 0x44, 0x20}, // 'b', 'c'
+		{0x38, 0x44, 0x44, 0x48, 0x7f}, {0x38, 0x54, 0x54, 0x54, 0x18}, // 'd', 'e'
+		{0x08, 0x7e, 0x09, 0x01, 0x02}, {0x18, 0xa4, 0xa4, 0xa4, 0x7c}, // 'f', 'g'
+		{0x7f, 0x08, 0x04, 0x04, 0x78}, {0x00, 0x44, 0x7d, 0x40, 0x00}, // 'h', 'i'
+		{0x40, 0x80, 0x84, 0x7d, 0x00}, {0x7f, 0x10, 0x28, 0x44, 0x00}, // 'j', 'k'
+		{0x00, 0x41, 0x7f, 0x40, 0x00}, {0x7c, 0x04, 0x18, 0x04, 0x78}, // 'l', 'm'
+		{0x7c, 0x08, 0x04, 0x04, 0x78}, {0x38, 0x44, 0x44, 0x44, 0x38}, // 'n', 'o'
+		{0xfc, 0x24, 0x24, 0x24, 0x18}, {0x18, 0x24, 0x24, 0x24, 0xfc}, // 'p', 'q'
+		{0x7c, 0x08, 0x04, 0x04, 0x08}, {0x48, 0x54, 0x54, 0x54, 0x20}, // 'r', 's'
+		{0x04, 0x3f, 0x44, 0x40, 0x20}, {0x3c, 0x40, 0x40, 0x20, 0x7c}, // 't', 'u'
+		{0x1c, 0x20, 0x40, 0x20, 0x1c}, {0x3c, 0x40, 0x30, 0x40, 0x3c}, // 'v', 'w'
+		{0x44, 0x28, 0x10, 0x28, 0x44}, {0x1c, 0xa0, 0xa0, 0xa0, 0x7c}, // 'x', 'y'
+		{0x44, 0x64, 0x54, 0x4c, 0x44}, {0x00, 0x08, 0x36, 0x41, 0x00}, // 'z', '{'
+		{0x00, 0x00, 0x7f, 0x00, 0x00}, {0x00, 0x41, 0x36, 0x08, 0x00}, // '|', '}'
+		{0x08, 0x04, 0x08, 0x10, 0x08}, // '~'
+	}
+
+	// fontGlyphsOther contains the 5x8 bitmaps for a few more characters used
+	// in the ready-to-use Styles
+	fontGlyphsOther = map[rune][5]byte{
+		'•': {0x00, 0x1c, 0x1c, 0x1c, 0x00},
+		'…': {0x40, 0x00, 0x40, 0x00, 0x40},
+		'≈': {0x24, 0x12, 0x24, 0x12, 0x24},
+		'■': {0x3e, 0x3e, 0x3e, 0x3e, 0x3e},
+		'▶': {0x7f, 0x3e, 0x1c, 0x08, 0x00},
+		'◆': {0x08, 0x1c, 0x3e, 0x1c, 0x08},
+		'◇': {0x08, 0x14, 0x22, 0x14, 0x08},
+		'◈': {0x08, 0x14, 0x2a, 0x14, 0x08},
+		'○': {0x1c, 0x22, 0x22, 0x22, 0x1c},
+		'◌': {0x14, 0x00, 0x22, 0x00, 0x14},
+		'●': {0x1c, 0x3e, 0x3e, 0x3e, 0x1c},
+		'★': {0x24, 0x1c, 0x0f, 0x1c, 0x24},
+		'✽': {0x2a, 0x1c, 0x3e, 0x1c, 0x2a},
+	}
+
+	// fontGlyphTofu is the bitmap for characters without a glyph
+	fontGlyphTofu = [5]byte{0x7f, 0x41, 0x41, 0x41, 0x7f}
+
+	// fontBoxLines contains the weights of the lines from the center of the
+	// cell to the top, right, bottom and left edges for box-drawing characters
+	fontBoxLines = map[rune][4]uint8{
+		'─': {0, 1, 0, 1}, '│': {1, 0, 1, 0}, '┌': {0, 1, 1, 0}, '┐': {0, 0, 1, 1},
+		'└': {1, 1, 0, 0}, '┘': {1, 0, 0, 1}, '├': {1, 1, 1, 0}, '┤': {1, 0, 1, 1},
+		'┬': {0, 1, 1, 1}, '┴': {1, 1, 0, 1}, '┼': {1, 1, 1, 1}, '╭': {0, 1, 1, 0},
+		'╮': {0, 0, 1, 1}, '╯': {1, 0, 0, 1}, '╰': {1, 1, 0, 0},
+		'━': {0, 2, 0, 2}, '┃': {2, 0, 2, 0}, '┏': {0, 2, 2, 0}, '┓': {0, 0, 2, 2},
+		'┗': {2, 2, 0, 0}, '┛': {2, 0, 0, 2}, '┣': {2, 2, 2, 0}, '┫': {2, 0, 2, 2},
+		'┳': {0, 2, 2, 2}, '┻': {2, 2, 0, 2}, '╋': {2, 2, 2, 2},
+		'═': {0, 3, 0, 3}, '║': {3, 0, 3, 0}, '╔': {0, 3, 3, 0}, '╗': {0, 0, 3, 3},
+		'╚': {3, 3, 0, 0}, '╝': {3, 0, 0, 3}, '╠': {3, 3, 3, 0}, '╣': {3, 0, 3, 3},
+		'╦': {0, 3, 3, 3}, '╩': {3, 3, 0, 3}, '╬': {3, 3, 3, 3},
+	}
+)
+
+// fontDrawRune draws the rune (in the bundled bitmap font) into the character
+// cell at the given position of the image, with each font-pixel being a
+// square of scale x scale pixels.
+func fontDrawRune(img *image.RGBA, x, y, scale int, r rune, c color.RGBA, bold bool) {
+	set := func(px, py int) {
+		fillRect(img, x+px*scale, y+py*scale, scale, scale, c)
+		if bold {
+			fillRect(img, x+(px+1)*scale, y+py*scale, scale, scale, c)
+		}
+	}
+
+	if lines, ok := fontBoxLines[r]; ok {
+		fontDrawBoxLines(img, x, y, scale, lines, c)
+	} else if fontDrawBlock(img, x, y, scale, r, c) {
+		// block elements (used in bars and sparklines) fill up the cell
+	} else if r > ' ' && r <= '~' {
+		fontDrawGlyph(fontGlyphsASCII[r-' '], set)
+	} else if glyph, ok := fontGlyphsOther[r]; ok {
+		fontDrawGlyph(glyph, set)
+	} else if r > ' ' {
+		fontDrawGlyph(fontGlyphTofu, set)
+	}
+}
+
+// fontDrawBlock draws the block element (full/partial blocks and shades) and
+// returns true if the rune is one.
+func fontDrawBlock(img *image.RGBA, x, y, scale int, r rune, c color.RGBA) bool {
+	switch {
+	case r == '█':
+		fillRect(img, x, y, fontCellWidth*scale, fontCellHeight*scale, c)
+	case r >= '▁' && r <= '▇': // lower one-eighth to seven-eighths
+		height := (int(r-'▁') + 1) * fontCellHeight * scale / 8
+		fillRect(img, x, y+fontCellHeight*scale-height, fontCellWidth*scale, height, c)
+	case r >= '▉' && r <= '▏': // left seven-eighths to one-eighth
+		width := (8 - int(r-'▉') - 1) * fontCellWidth * scale / 8
+		if width < scale {
+			width = scale
+		}
+		fillRect(img, x, y, width, fontCellHeight*scale, c)
+	case r == '░' || r == '▒' || r == '▓':
+		for py := 0; py < fontCellHeight; py++ {
+			for px := 0; px < fontCellWidth; px++ {
+				if (r == '░' && (px+py)%4 == 0) || (r == '▒' && (px+py)%2 == 0) || (r == '▓' && (px+py)%4 != 0) {
+					fillRect(img, x+px*scale, y+py*scale, scale, scale, c)
+				}
+			}
+		}
+	default:
+		return false
+	}
+	return true
+}
+
+// fontDrawBoxLines draws the lines of a box-drawing character from the center
+// of the cell to its edges.
+func fontDrawBoxLines(img *image.RGBA, x, y, scale int, lines [4]uint8, c color.RGBA) {
+	centerX, centerY := fontCellWidth/2-1, fontCellHeight/2-1
+	for direction, weight := range lines {
+		if weight == boxLineNone {
+			continue
+		}
+
+		// offsets (from the center) of the parallel strokes making the line
+		offsets := []int{0}
+		if weight == boxLineHeavy {
+			offsets = []int{-1, 0, 1}
+		} else if weight == boxLineDouble {
+			offsets = []int{-1, 1}
+		}
+		for _, offset := range offsets {
+			switch direction {
+			case 0: // top
+				fillRect(img, x+(centerX+offset)*scale, y, scale, (centerY+1)*scale, c)
+			case 1: // right
+				fillRect(img, x+centerX*scale, y+(centerY+offset)*scale, (fontCellWidth-centerX)*scale, scale, c)
+			case 2: // bottom
+				fillRect(img, x+(centerX+offset)*scale, y+centerY*scale, scale, (fontCellHeight-centerY)*scale, c)
+			case 3: // left
+				fillRect(img, x, y+(centerY+offset)*scale, (centerX+1)*scale, scale, c)
+			}
+		}
+	}
+}
+
+// fontDrawGlyph calls set for every font-pixel that is on in the glyph.
+func fontDrawGlyph(glyph [5]byte, set func(px, py int)) {
+	for px, column := range glyph {
+		for py := 0; py < 8; py++ {
+			if column&(1<<uint(py)) != 0 {
+				set(px, fontGlyphTop+py)
+			}
+		}
+	}
+}
+
+// fillRect fills the rectangle in the image with the color.
+func fillRect(img *image.RGBA, x, y, width, height int, c color.RGBA) {
+	rect := image.Rect(x, y, x+width, y+height).Intersect(img.Bounds())
+	for py := rect.Min.Y; py < rect.Max.Y; py++ {
+		for px := rect.Min.X; px < rect.Max.X; px++ {
+			img.SetRGBA(px, py, c)
+		}
+	}
+}
@@ -0,0 +1,131 @@
+package text
+
+import (
+	"fmt"
+	"image/color"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func ExampleANSIToSVG() {
+	fmt.Println(ANSIToSVG("\x1b[91mGhost\x1b[0m", ImageOptions{}))
+
+	// Output: <svg xmlns="http://www.w3.org/2000/svg" width="42" height="16.8" viewBox="0 0 42 16.8">
+	// <rect width="100%" height="100%" fill="#000000"/>
+	// <g font-family="monospace" font-size="14" xml:space="preserve">
+	// <text x="0" y="14" fill="#ff0000" textLength="42" lengthAdjust="spacingAndGlyphs">Ghost</text>
+	// </g>
+	// </svg>
+}
+
+func TestANSIToSVG(t *testing.T) {
+	svg := ANSIToSVG("Ghost\n\x1b[1;41m<Lady>\x1b[0m  \x1b[4;3;38;2;16;32;48mNymeria\x1b[0m", ImageOptions{
+		Background: BgWhite,
+		Foreground: FgBlack,
+		FontFamily: "Fira Code",
+		FontSize:   10,
+		Padding:    1,
+	})
+	expected := `<svg xmlns="http://www.w3.org/2000/svg" width="102" height="48" viewBox="0 0 102 48">
+<rect width="100%" height="100%" fill="#e5e5e5"/>
+<g font-family="Fira Code" font-size="10" xml:space="preserve">
+<text x="6" y="22" fill="#000000" textLength="30" lengthAdjust="spacingAndGlyphs">Ghost</text>
+<rect x="6" y="24" width="36" height="12" fill="#cd0000"/>
+<text x="6" y="34" fill="#000000" font-weight="bold" textLength="36" lengthAdjust="spacingAndGlyphs">&lt;Lady&gt;</text>
+<text x="54" y="34" fill="#102030" font-style="italic" text-decoration="underline" textLength="42" lengthAdjust="spacingAndGlyphs">Nymeria</text>
+</g>
+</svg>`
+	assert.Equal(t, expected, svg)
+}
+
+func TestANSIToSVG_Attributes(t *testing.T) {
+	render := func(str string) string {
+		svg := ANSIToSVG(str, ImageOptions{})
+		lines := strings.Split(svg, "\n")
+		return strings.Join(lines[3:len(lines)-2], "\n")
+	}
+
+	// reverse-video swaps the colors
+	assert.Equal(t, `<rect x="0" y="0" width="8.4" height="16.8" fill="#e5e5e5"/>
+<text x="0" y="14" fill="#000000" textLength="8.4" lengthAdjust="spacingAndGlyphs">A</text>`,
+		render("\x1b[7mA"))
+	// faint blends the text color into the background
+	assert.Equal(t, `<text x="0" y="14" fill="#727272" textLength="8.4" lengthAdjust="spacingAndGlyphs">A</text>`,
+		render("\x1b[2mA"))
+	// concealed text is not visible
+	assert.Equal(t, `<text x="0" y="14" fill="#000000" textLength="8.4" lengthAdjust="spacingAndGlyphs">A</text>`,
+		render("\x1b[8mA"))
+	// underline and crossed-out
+	assert.Equal(t, `<text x="0" y="14" fill="#e5e5e5" text-decoration="underline line-through" textLength="16.8" lengthAdjust="spacingAndGlyphs">  </text>`,
+		render("\x1b[4;9m  "))
+	// wide characters take up two cells
+	assert.Equal(t, `<text x="0" y="14" fill="#e5e5e5" textLength="16.8" lengthAdjust="spacingAndGlyphs">ツ</text>
+<text x="16.8" y="14" fill="#00cd00" textLength="8.4" lengthAdjust="spacingAndGlyphs">A</text>`,
+		render("ツ\x1b[32mA"))
+	// other escape sequences are dropped
+	assert.Equal(t, `<text x="0" y="14" fill="#e5e5e5" textLength="42" lengthAdjust="spacingAndGlyphs">Ghost</text>`,
+		render(Hyperlink("https://github.com", "Ghost")))
+}
+
+func TestANSIToImage(t *testing.T) {
+	img := ANSIToImage("Ab\n\x1b[44m─\x1b[0m", ImageOptions{Padding: 1, Scale: 1})
+	assert.Equal(t, 4*fontCellWidth, img.Bounds().Dx())
+	assert.Equal(t, 4*fontCellHeight, img.Bounds().Dy())
+
+	black := color.RGBA{A: 0xff}
+	white := color.RGBA{R: 0xe5, G: 0xe5, B: 0xe5, A: 0xff}
+	blue := color.RGBA{B: 0xee, A: 0xff}
+	// padding
+	assert.Equal(t, black, img.RGBAAt(0, 0))
+	// top-left corner of 'A' is blank, and the left-most column has a pixel
+	// on the 2nd row of the glyph
+	x, y := fontCellWidth, fontCellHeight+fontGlyphTop
+	assert.Equal(t, black, img.RGBAAt(x, y))
+	assert.Equal(t, white, img.RGBAAt(x, y+1))
+	// the horizontal line goes across the whole cell on a blue background
+	x, y = fontCellWidth, 2*fontCellHeight
+	assert.Equal(t, blue, img.RGBAAt(x, y))
+	for col := 0; col < fontCellWidth; col++ {
+		assert.Equal(t, white, img.RGBAAt(x+col, y+fontCellHeight/2-1))
+	}
+	// the 2nd cell on the line with the box-drawing character is just padding
+	assert.Equal(t, black, img.RGBAAt(x+fontCellWidth, y))
+}
+
+func TestANSIToImage_Scale(t *testing.T) {
+	img := ANSIToImage("Ghost", ImageOptions{})
+	assert.Equal(t, 5*fontCellWidth*DefaultImageScale, img.Bounds().Dx())
+	assert.Equal(t, fontCellHeight*DefaultImageScale, img.Bounds().Dy())
+
+	img = ANSIToImage("Ghost", ImageOptions{Scale: 3})
+	assert.Equal(t, 5*fontCellWidth*3, img.Bounds().Dx())
+	assert.Equal(t, fontCellHeight*3, img.Bounds().Dy())
+}
+
+func TestANSIToImage_Glyphs(t *testing.T) {
+	render := func(r rune) []byte {
+		return ANSIToImage(string(r), ImageOptions{Scale: 1}).Pix
+	}
+	countPixels := func(r rune) int {
+		count := 0
+		for idx, value := range render(r) {
+			if idx%4 == 0 && value != 0 {
+				count++
+			}
+		}
+		return count
+	}
+
+	// all the characters used by the ready-to-use Styles have a glyph, and do
+	// not get drawn as the hollow box
+	tofu := render('⌘')
+	assert.Equal(t, 0, countPixels(' '))
+	for _, r := range "─│┌┐└┘├┤┬┴┼╭╮╯╰━┃┏┓┗┛┣┫┳┻╋═║╔╗╚╝╠╣╦╩╬█▁▄▇▉▌▏░▒▓•■●○◆" {
+		assert.NotEqual(t, tofu, render(r), string(r))
+	}
+	assert.Equal(t, fontCellWidth*fontCellHeight, countPixels('█'))
+	assert.Equal(t, fontCellWidth*fontCellHeight/2, countPixels('▄'))
+	assert.Equal(t, fontCellWidth*fontCellHeight/2, countPixels('▌'))
+}
@@ -30,12 +30,9 @@ func InsertEveryN(str string, runeToInsert rune, n int) string {
 	sLen := RuneCount(str)
 	var out strings.Builder
 	out.Grow(sLen + (sLen / n))
-	outLen, isEscSeq := 0, false
+	outLen, parser := 0, escSeqParser{}
 	for idx, c := range str {
-		if c == EscapeStartRune {
-			isEscSeq = true
-		}
-
+		isEscSeq := parser.consume(c)
 		if !isEscSeq && outLen > 0 && (outLen%n) == 0 && idx != sLen {
 			out.WriteRune(runeToInsert)
 		}
@@ -43,10 +40,6 @@ func InsertEveryN(str string, runeToInsert rune, n int) string {
 		if !isEscSeq {
 			outLen += RuneWidth(c)
 		}
-
-		if isEscSeq && c == EscapeStopRune {
-			isEscSeq = false
-		}
 	}
 	return out.String()
 }
@@ -55,12 +48,9 @@ func InsertEveryN(str string, runeToInsert rune, n int) string {
 // argument string. For ex.:
 //  LongestLineLen("Ghost!\nCome back here!\nRight now!") == 15
 func LongestLineLen(str string) int {
-	maxLength, currLength, isEscSeq := 0, 0, false
+	maxLength, currLength, parser := 0, 0, escSeqParser{}
 	for _, c := range str {
-		if c == EscapeStartRune {
-			isEscSeq = true
-		} else if isEscSeq && c == EscapeStopRune {
-			isEscSeq = false
+		if parser.consume(c) {
 			continue
 		}
 
@@ -69,7 +59,7 @@ func LongestLineLen(str string) int {
 				maxLength = currLength
 			}
 			currLength = 0
-		} else if !isEscSeq {
+		} else {
 			currLength += RuneWidth(c)
 		}
 	}
@@ -118,15 +108,9 @@ func RepeatAndTrim(str string, maxRunes int) string {
 //  RuneCount("\x1b[33mGhost\x1b[0m") == 5
 //  RuneCount("\x1b[33mGhost\x1b[0") == 5
 func RuneCount(str string) int {
-	count, isEscSeq := 0, false
+	count, parser := 0, escSeqParser{}
 	for _, c := range str {
-		if c == EscapeStartRune {
-			isEscSeq = true
-		} else if isEscSeq {
-			if c == EscapeStopRune {
-				isEscSeq = false
-			}
-		} else {
+		if !parser.consume(c) {
 			count += RuneWidth(c)
 		}
 	}
@@ -163,6 +147,47 @@ func Snip(str string, length int, snipIndicator string) string {
 	return str
 }
 
+// SnipLeft is similar to Snip, except for the fact that it drops characters
+// from the left of the string, and retains the trailing characters. For ex.:
+//  SnipLeft("Ghost", 0, "~") == "Ghost"
+//  SnipLeft("Ghost", 1, "~") == "~"
+//  SnipLeft("Ghost", 3, "~") == "~st"
+//  SnipLeft("Ghost", 5, "~") == "Ghost"
+//  SnipLeft("\x1b[33mGhost\x1b[0m", 3, "~") == "~\x1b[33mst\x1b[0m"
+func SnipLeft(str string, length int, snipIndicator string) string {
+	if length > 0 {
+		lenStr := RuneCount(str)
+		if lenStr > length {
+			lenStrFinal := length - RuneCount(snipIndicator)
+			return snipIndicator + TrimLeft(str, lenStrFinal)
+		}
+	}
+	return str
+}
+
+// SnipMiddle is similar to Snip, except for the fact that it drops characters
+// from the middle of the string, and retains the leading and the trailing
+// characters. This is useful for things like file-system paths. For ex.:
+//  SnipMiddle("Ghost", 0, "~") == "Ghost"
+//  SnipMiddle("Ghost", 1, "~") == "~"
+//  SnipMiddle("Ghost", 3, "~") == "G~t"
+//  SnipMiddle("Ghost", 4, "~") == "Gh~t"
+//  SnipMiddle("/usr/local/bin", 10, "…") == "/usr/…/bin"
+func SnipMiddle(str string, length int, snipIndicator string) string {
+	if length > 0 {
+		lenStr := RuneCount(str)
+		if lenStr > length {
+			lenStrFinal := length - RuneCount(snipIndicator)
+			if lenStrFinal <= 0 {
+				return snipIndicator
+			}
+			lenLeft := lenStrFinal - lenStrFinal/2
+			return Trim(str, lenLeft) + snipIndicator + TrimLeft(str, lenStrFinal-lenLeft)
+		}
+	}
+	return str
+}
+
 // Trim trims a string to the given length while ignoring escape sequences. For
 // ex.:
 //  Trim("Ghost", 3) == "Gho"
@@ -177,17 +202,12 @@ func Trim(str string, maxLen int) string {
 	var out strings.Builder
 	out.Grow(maxLen)
 
-	outLen, isEscSeq, lastEscSeq := 0, false, strings.Builder{}
+	outLen, parser, lastEscSeq := 0, escSeqParser{}, ""
 	for _, sChr := range str {
 		out.WriteRune(sChr)
-		if sChr == EscapeStartRune {
-			isEscSeq = true
-			lastEscSeq.Reset()
-			lastEscSeq.WriteRune(sChr)
-		} else if isEscSeq {
-			lastEscSeq.WriteRune(sChr)
-			if sChr == EscapeStopRune {
-				isEscSeq = false
+		if parser.consume(sChr) {
+			if escSeq := parser.completedCSI(); escSeq != "" {
+				lastEscSeq = escSeq
 			}
 		} else {
 			outLen++
@@ -196,8 +216,45 @@ func Trim(str string, maxLen int) string {
 			}
 		}
 	}
-	if lastEscSeq.Len() > 0 && lastEscSeq.String() != EscapeReset {
+	if parser.inEscSeq && !parser.isOSC {
+		lastEscSeq = parser.seq.String()
+	}
+	if lastEscSeq != "" && lastEscSeq != EscapeReset {
 		out.WriteString(EscapeReset)
 	}
+	if parser.hyperlink != "" {
+		out.WriteString(hyperlinkEnd)
+	}
+	return out.String()
+}
+
+// TrimLeft trims a string to the given length by dropping characters from the
+// left while ignoring escape sequences. Escape sequences in the dropped part of
+// the string are retained so that the remaining text is rendered as before.
+// For ex.:
+//  TrimLeft("Ghost", 3) == "ost"
+//  TrimLeft("Ghost", 6) == "Ghost"
+//  TrimLeft("\x1b[33mGhost\x1b[0m", 3) == "\x1b[33most\x1b[0m"
+//  TrimLeft("\x1b[33mGhost\x1b[0m", 6) == "\x1b[33mGhost\x1b[0m"
+func TrimLeft(str string, maxLen int) string {
+	if maxLen <= 0 {
+		return ""
+	}
+	numToDrop := RuneCount(str) - maxLen
+	if numToDrop <= 0 {
+		return str
+	}
+
+	var out strings.Builder
+	out.Grow(len(str))
+
+	numDropped, parser := 0, escSeqParser{}
+	for _, sChr := range str {
+		if parser.consume(sChr) || numDropped >= numToDrop {
+			out.WriteRune(sChr)
+		} else {
+			numDropped += RuneWidth(sChr)
+		}
+	}
 	return out.String()
 }
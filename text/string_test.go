@@ -147,6 +147,8 @@ func TestRuneCount(t *testing.T) {
 	assert.Equal(t, 7, RuneCount("Ghostツ"))
 	assert.Equal(t, 5, RuneCount("\x1b[33mGhost\x1b[0m"))
 	assert.Equal(t, 5, RuneCount("\x1b[33mGhost\x1b[0"))
+	assert.Equal(t, 5, RuneCount("\x1b]8;;https://example.com/m\x1b\\Ghost\x1b]8;;\x1b\\"))
+	assert.Equal(t, 5, RuneCount("\x1b]8;;https://example.com/m\aGhost\x1b]8;;\a"))
 }
 
 func ExampleRuneWidth() {
@@ -196,6 +198,56 @@ func TestSnip(t *testing.T) {
 	assert.Equal(t, "\x1b[33mGhost\x1b[0m", Snip("\x1b[33mGhost\x1b[0m", 7, "~"))
 }
 
+func ExampleSnipLeft() {
+	fmt.Printf("SnipLeft(\"Ghost\", 0, \"~\"): %#v\n", SnipLeft("Ghost", 0, "~"))
+	fmt.Printf("SnipLeft(\"Ghost\", 1, \"~\"): %#v\n", SnipLeft("Ghost", 1, "~"))
+	fmt.Printf("SnipLeft(\"Ghost\", 3, \"~\"): %#v\n", SnipLeft("Ghost", 3, "~"))
+	fmt.Printf("SnipLeft(\"Ghost\", 5, \"~\"): %#v\n", SnipLeft("Ghost", 5, "~"))
+	fmt.Printf("SnipLeft(\"\\x1b[33mGhost\\x1b[0m\", 3, \"~\"): %#v\n", SnipLeft("\x1b[33mGhost\x1b[0m", 3, "~"))
+
+	// Output: SnipLeft("Ghost", 0, "~"): "Ghost"
+	// SnipLeft("Ghost", 1, "~"): "~"
+	// SnipLeft("Ghost", 3, "~"): "~st"
+	// SnipLeft("Ghost", 5, "~"): "Ghost"
+	// SnipLeft("\x1b[33mGhost\x1b[0m", 3, "~"): "~\x1b[33mst\x1b[0m"
+}
+
+func TestSnipLeft(t *testing.T) {
+	assert.Equal(t, "Ghost", SnipLeft("Ghost", 0, "~"))
+	assert.Equal(t, "~", SnipLeft("Ghost", 1, "~"))
+	assert.Equal(t, "~st", SnipLeft("Ghost", 3, "~"))
+	assert.Equal(t, "Ghost", SnipLeft("Ghost", 5, "~"))
+	assert.Equal(t, "Ghost", SnipLeft("Ghost", 7, "~"))
+	assert.Equal(t, "…d4e5f6", SnipLeft("a1b2c3d4e5f6", 7, "…"))
+	assert.Equal(t, "~\x1b[33mst\x1b[0m", SnipLeft("\x1b[33mGhost\x1b[0m", 3, "~"))
+	assert.Equal(t, "\x1b[33mGhost\x1b[0m", SnipLeft("\x1b[33mGhost\x1b[0m", 7, "~"))
+}
+
+func ExampleSnipMiddle() {
+	fmt.Printf("SnipMiddle(\"Ghost\", 0, \"~\"): %#v\n", SnipMiddle("Ghost", 0, "~"))
+	fmt.Printf("SnipMiddle(\"Ghost\", 1, \"~\"): %#v\n", SnipMiddle("Ghost", 1, "~"))
+	fmt.Printf("SnipMiddle(\"Ghost\", 3, \"~\"): %#v\n", SnipMiddle("Ghost", 3, "~"))
+	fmt.Printf("SnipMiddle(\"Ghost\", 4, \"~\"): %#v\n", SnipMiddle("Ghost", 4, "~"))
+	fmt.Printf("SnipMiddle(\"/usr/local/bin\", 10, \"…\"): %#v\n", SnipMiddle("/usr/local/bin", 10, "…"))
+
+	// Output: SnipMiddle("Ghost", 0, "~"): "Ghost"
+	// SnipMiddle("Ghost", 1, "~"): "~"
+	// SnipMiddle("Ghost", 3, "~"): "G~t"
+	// SnipMiddle("Ghost", 4, "~"): "Gh~t"
+	// SnipMiddle("/usr/local/bin", 10, "…"): "/usr/…/bin"
+}
+
+func TestSnipMiddle(t *testing.T) {
+	assert.Equal(t, "Ghost", SnipMiddle("Ghost", 0, "~"))
+	assert.Equal(t, "~", SnipMiddle("Ghost", 1, "~"))
+	assert.Equal(t, "~~~", SnipMiddle("Ghost", 2, "~~~"))
+	assert.Equal(t, "G~t", SnipMiddle("Ghost", 3, "~"))
+	assert.Equal(t, "Gh~t", SnipMiddle("Ghost", 4, "~"))
+	assert.Equal(t, "Ghost", SnipMiddle("Ghost", 5, "~"))
+	assert.Equal(t, "/usr/…/bin", SnipMiddle("/usr/local/bin", 10, "…"))
+	assert.Equal(t, "\x1b[33mG\x1b[0m~\x1b[33mt\x1b[0m", SnipMiddle("\x1b[33mGhost\x1b[0m", 3, "~"))
+}
+
 func ExampleTrim() {
 	fmt.Printf("Trim(\"Ghost\", 0): %#v\n", Trim("Ghost", 0))
 	fmt.Printf("Trim(\"Ghost\", 3): %#v\n", Trim("Ghost", 3))
@@ -218,4 +270,33 @@ func TestTrim(t *testing.T) {
 	assert.Equal(t, "Ghost", Trim("Ghost", 6))
 	assert.Equal(t, "\x1b[33mGho\x1b[0m", Trim("\x1b[33mGhost\x1b[0m", 3))
 	assert.Equal(t, "\x1b[33mGhost\x1b[0m", Trim("\x1b[33mGhost\x1b[0m", 6))
+	assert.Equal(t, "\x1b]8;;https://example.com/m\x1b\\Gho\x1b]8;;\x1b\\", Trim(Hyperlink("https://example.com/m", "Ghost"), 3))
+	assert.Equal(t, "\x1b]8;;https://example.com/m\x1b\\Ghost\x1b]8;;\x1b\\", Trim(Hyperlink("https://example.com/m", "Ghost"), 6))
+	assert.Equal(t, "\x1b[33m\x1b]8;;https://example.com/m\x1b\\Gho\x1b[0m\x1b]8;;\x1b\\", Trim(FgYellow.Sprint(Hyperlink("https://example.com/m", "Ghost")), 3))
+}
+
+func ExampleTrimLeft() {
+	fmt.Printf("TrimLeft(\"Ghost\", 0): %#v\n", TrimLeft("Ghost", 0))
+	fmt.Printf("TrimLeft(\"Ghost\", 3): %#v\n", TrimLeft("Ghost", 3))
+	fmt.Printf("TrimLeft(\"Ghost\", 6): %#v\n", TrimLeft("Ghost", 6))
+	fmt.Printf("TrimLeft(\"\\x1b[33mGhost\\x1b[0m\", 0): %#v\n", TrimLeft("\x1b[33mGhost\x1b[0m", 0))
+	fmt.Printf("TrimLeft(\"\\x1b[33mGhost\\x1b[0m\", 3): %#v\n", TrimLeft("\x1b[33mGhost\x1b[0m", 3))
+	fmt.Printf("TrimLeft(\"\\x1b[33mGhost\\x1b[0m\", 6): %#v\n", TrimLeft("\x1b[33mGhost\x1b[0m", 6))
+
+	// Output: TrimLeft("Ghost", 0): ""
+	// TrimLeft("Ghost", 3): "ost"
+	// TrimLeft("Ghost", 6): "Ghost"
+	// TrimLeft("\x1b[33mGhost\x1b[0m", 0): ""
+	// TrimLeft("\x1b[33mGhost\x1b[0m", 3): "\x1b[33most\x1b[0m"
+	// TrimLeft("\x1b[33mGhost\x1b[0m", 6): "\x1b[33mGhost\x1b[0m"
+}
+
+func TestTrimLeft(t *testing.T) {
+	assert.Equal(t, "", TrimLeft("Ghost", 0))
+	assert.Equal(t, "ost", TrimLeft("Ghost", 3))
+	assert.Equal(t, "Ghost", TrimLeft("Ghost", 6))
+	assert.Equal(t, "ツ", TrimLeft("Gツ", 2))
+	assert.Equal(t, "\x1b[33most\x1b[0m", TrimLeft("\x1b[33mGhost\x1b[0m", 3))
+	assert.Equal(t, "\x1b[33mGhost\x1b[0m", TrimLeft("\x1b[33mGhost\x1b[0m", 6))
+	assert.Equal(t, "\x1b[33m\x1b[0m\x1b[34mst\x1b[0m", TrimLeft("\x1b[33mGho\x1b[0m\x1b[34mst\x1b[0m", 2))
 }
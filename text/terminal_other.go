@@ -0,0 +1,13 @@
+// +build !darwin,!dragonfly,!freebsd,!linux,!netbsd,!openbsd,!solaris,!windows
+
+package text
+
+import (
+	"io"
+)
+
+// getTerminalWidth returns 0 as the width of the terminal cannot be
+// determined on this platform.
+func getTerminalWidth(w io.Writer) int {
+	return 0
+}
@@ -0,0 +1,24 @@
+// +build darwin dragonfly freebsd linux netbsd openbsd solaris
+
+package text
+
+import (
+	"io"
+	"os"
+
+	"golang.org/x/sys/unix"
+)
+
+// getTerminalWidth returns the width of the terminal the writer is attached
+// to, or 0 if it is not a terminal.
+func getTerminalWidth(w io.Writer) int {
+	f, ok := w.(*os.File)
+	if !ok || f == nil {
+		return 0
+	}
+	winSize, err := unix.IoctlGetWinsize(int(f.Fd()), unix.TIOCGWINSZ)
+	if err != nil {
+		return 0
+	}
+	return int(winSize.Col)
+}
@@ -109,6 +109,21 @@ func transformFloat(format string, val float64) string {
 	return colorsNumberZero.Sprintf(format, val)
 }
 
+// NewHyperlinkTransformer returns a Transformer that renders the value (an URL)
+// as a clickable hyperlink using the OSC 8 escape sequence (the text is also
+// underlined and colored Blue like with NewURLTransformer). If a labeler
+// function is provided, it is used to determine the label to use for the URL.
+func NewHyperlinkTransformer(labeler func(url string) string) Transformer {
+	return func(val interface{}) string {
+		url := fmt.Sprint(val)
+		label := url
+		if labeler != nil {
+			label = labeler(url)
+		}
+		return Hyperlink(url, colorsURL.Sprint(label))
+	}
+}
+
 // NewJSONTransformer returns a Transformer that can format a JSON string or an
 // object into pretty-indented JSON-strings.
 func NewJSONTransformer(prefix string, indent string) Transformer {
@@ -88,6 +88,19 @@ type jsonNestTest struct {
 	C float64
 }
 
+func TestNewHyperlinkTransformer(t *testing.T) {
+	url := "https://winter.is.coming"
+
+	transformer := NewHyperlinkTransformer(nil)
+	assert.Equal(t, Hyperlink(url, colorsURL.Sprint(url)), transformer(url))
+
+	transformer = NewHyperlinkTransformer(func(url string) string {
+		return strings.TrimPrefix(url, "https://")
+	})
+	assert.Equal(t, Hyperlink(url, colorsURL.Sprint("winter.is.coming")), transformer(url))
+	assert.Equal(t, 16, RuneCount(transformer(url)))
+}
+
 func TestNewJSONTransformer(t *testing.T) {
 	transformer := NewJSONTransformer("", "    ")
 
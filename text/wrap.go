@@ -39,25 +39,28 @@ func WrapHard(str string, wrapLen int) string {
 //
 // For examples, refer to the unit-tests or GoDoc examples.
 func WrapSoft(str string, wrapLen int) string {
-	if wrapLen <= 0 {
-		return ""
-	}
-	str = strings.Replace(str, "\t", "    ", -1)
-	sLen := utf8.RuneCountInString(str)
-	if sLen <= wrapLen {
-		return str
-	}
+	return wrapSoftWith(str, wrapLen, appendWord)
+}
 
-	out := &strings.Builder{}
-	out.Grow(sLen + (sLen / wrapLen))
-	for idx, paragraph := range strings.Split(str, "\n\n") {
-		if idx > 0 {
-			out.WriteString("\n\n")
-		}
-		wrapSoft(paragraph, wrapLen, out)
-	}
+// WrapSoftKeepURLs is very similar to WrapSoft except for one difference.
+// Unlike WrapSoft which breaks all words that do not fit within a single line,
+// this function moves URLs to a line of their own and does not break them, so
+// that they remain usable (clickable) in the output. Lines containing such URLs
+// may be longer than the given length.
+//
+// For examples, refer to the unit-tests or GoDoc examples.
+func WrapSoftKeepURLs(str string, wrapLen int) string {
+	return wrapSoftWith(str, wrapLen, appendWordKeepURL)
+}
 
-	return out.String()
+// WrapHyphenated is very similar to WrapSoft except for one difference. Unlike
+// WrapSoft which breaks words that do not fit within a single line abruptly,
+// this function breaks them with a trailing hyphen ("-") to denote that the
+// word continues in the next line.
+//
+// For examples, refer to the unit-tests or GoDoc examples.
+func WrapHyphenated(str string, wrapLen int) string {
+	return wrapSoftWith(str, wrapLen, appendWordHyphenated)
 }
 
 // WrapText is very similar to WrapHard except for one minor difference. Unlike
@@ -73,28 +76,19 @@ func WrapText(str string, wrapLen int) string {
 	var out strings.Builder
 	sLen := utf8.RuneCountInString(str)
 	out.Grow(sLen + (sLen / wrapLen))
-	lineIdx, isEscSeq, lastEscSeq := 0, false, ""
+	lineIdx, parser, lastEscSeq := 0, escSeqParser{}, ""
 	for _, char := range str {
-		if char == EscapeStartRune {
-			isEscSeq = true
-			lastEscSeq = ""
-		}
-		if isEscSeq {
-			lastEscSeq += string(char)
-		}
-
-		appendChar(char, wrapLen, &lineIdx, isEscSeq, lastEscSeq, &out)
-
-		if isEscSeq && char == EscapeStopRune {
-			isEscSeq = false
+		isEscSeq := parser.consume(char)
+		if escSeq := parser.completedCSI(); escSeq != "" {
+			lastEscSeq = escSeq
 		}
 		if lastEscSeq == EscapeReset {
 			lastEscSeq = ""
 		}
+
+		appendChar(char, wrapLen, &lineIdx, isEscSeq, lastEscSeq+parser.hyperlink, &out)
 	}
-	if lastEscSeq != "" && lastEscSeq != EscapeReset {
-		out.WriteString(EscapeReset)
-	}
+	out.WriteString(escSeqEnd(lastEscSeq + parser.hyperlink))
 	return out.String()
 }
 
@@ -105,7 +99,7 @@ func appendChar(char rune, wrapLen int, lineLen *int, inEscSeq bool, lastSeenEsc
 		if lastSeenEscSeq != "" {
 			// terminate escape sequence and the line; and restart the escape
 			// sequence in the next line
-			out.WriteString(EscapeReset)
+			out.WriteString(escSeqEnd(lastSeenEscSeq))
 			out.WriteRune('\n')
 			out.WriteString(lastSeenEscSeq)
 		} else {
@@ -127,40 +121,93 @@ func appendChar(char rune, wrapLen int, lineLen *int, inEscSeq bool, lastSeenEsc
 	}
 }
 
+// wordAppender appends a word that does not fit within a single line to the
+// output.
+type wordAppender func(word string, lineLen *int, lastSeenEscSeq string, wrapLen int, out *strings.Builder)
+
 func appendWord(word string, lineIdx *int, lastSeenEscSeq string, wrapLen int, out *strings.Builder) {
-	inEscSeq := false
+	lastSeenEscSeq, hyperlink := splitEscSeq(lastSeenEscSeq)
+	parser := escSeqParser{hyperlink: hyperlink}
 	for _, char := range word {
-		if char == EscapeStartRune {
-			inEscSeq = true
-			lastSeenEscSeq = ""
+		inEscSeq := parser.consume(char)
+		if escSeq := parser.completedCSI(); escSeq != "" {
+			lastSeenEscSeq = escSeq
 		}
-		if inEscSeq {
-			lastSeenEscSeq += string(char)
+		if lastSeenEscSeq == EscapeReset {
+			lastSeenEscSeq = ""
 		}
 
-		appendChar(char, wrapLen, lineIdx, inEscSeq, lastSeenEscSeq, out)
+		appendChar(char, wrapLen, lineIdx, inEscSeq, lastSeenEscSeq+parser.hyperlink, out)
+	}
+}
+
+func appendWordHyphenated(word string, lineLen *int, lastSeenEscSeq string, wrapLen int, out *strings.Builder) {
+	// a hyphen on a line by itself helps no one
+	if wrapLen < 2 {
+		appendWord(word, lineLen, lastSeenEscSeq, wrapLen, out)
+		return
+	}
 
-		if inEscSeq && char == EscapeStopRune {
-			inEscSeq = false
+	lastSeenEscSeq, hyperlink := splitEscSeq(lastSeenEscSeq)
+	parser, numCharsPending := escSeqParser{hyperlink: hyperlink}, RuneCount(word)
+	for _, char := range word {
+		if parser.consume(char) {
+			if escSeq := parser.completedCSI(); escSeq != "" {
+				lastSeenEscSeq = escSeq
+			}
+			if lastSeenEscSeq == EscapeReset {
+				lastSeenEscSeq = ""
+			}
+			out.WriteRune(char)
+			continue
 		}
-		if lastSeenEscSeq == EscapeReset {
-			lastSeenEscSeq = ""
+
+		// break the word with a hyphen if the rest of it doesn't fit within
+		// the line, and there is no space left for anything but the hyphen
+		charLen := RuneWidth(char)
+		if *lineLen > 0 && *lineLen+numCharsPending > wrapLen && *lineLen+charLen >= wrapLen {
+			out.WriteRune('-')
+			*lineLen++
+			terminateLine(wrapLen, lineLen, lastSeenEscSeq+parser.hyperlink, out)
 		}
+		out.WriteRune(char)
+		*lineLen += charLen
+		numCharsPending -= charLen
+	}
+}
+
+func appendWordKeepURL(word string, lineLen *int, lastSeenEscSeq string, wrapLen int, out *strings.Builder) {
+	if isURL(StripEscape(word)) {
+		out.WriteString(word)
+		*lineLen += RuneCount(word)
+	} else {
+		appendWord(word, lineLen, lastSeenEscSeq, wrapLen, out)
+	}
+}
+
+// escSeqEnd returns the escape sequences needed to terminate the given open
+// escape sequences (colors and/or a hyperlink).
+func escSeqEnd(openEscSeq string) string {
+	escSeq, hyperlink := splitEscSeq(openEscSeq)
+	rsp := ""
+	if escSeq != "" && escSeq != EscapeReset {
+		rsp += EscapeReset
+	}
+	if hyperlink != "" {
+		rsp += hyperlinkEnd
 	}
+	return rsp
 }
 
-func extractOpenEscapeSeq(str string) string {
-	escapeSeq, inEscSeq := "", false
+// extractOpenEscapeSeq returns the last color escape sequence in the string
+// that has not been reset, and updates the parser (that has been fed all the
+// strings before this one) with the state at the end of the string.
+func extractOpenEscapeSeq(str string, parser *escSeqParser) string {
+	escapeSeq := ""
 	for _, char := range str {
-		if char == EscapeStartRune {
-			inEscSeq = true
-			escapeSeq = ""
-		}
-		if inEscSeq {
-			escapeSeq += string(char)
-		}
-		if char == EscapeStopRune {
-			inEscSeq = false
+		parser.consume(char)
+		if escSeq := parser.completedCSI(); escSeq != "" {
+			escapeSeq = escSeq
 		}
 	}
 	if escapeSeq == EscapeReset {
@@ -169,30 +216,37 @@ func extractOpenEscapeSeq(str string) string {
 	return escapeSeq
 }
 
+func isURL(word string) bool {
+	return strings.Contains(word, "://") || strings.HasPrefix(word, "www.")
+}
+
 func terminateLine(wrapLen int, lineLen *int, lastSeenEscSeq string, out *strings.Builder) {
 	if *lineLen < wrapLen {
 		out.WriteString(strings.Repeat(" ", wrapLen-*lineLen))
 	}
 	// something is already on the line; terminate it
-	if lastSeenEscSeq != "" {
-		out.WriteString(EscapeReset)
-	}
+	out.WriteString(escSeqEnd(lastSeenEscSeq))
 	out.WriteRune('\n')
 	out.WriteString(lastSeenEscSeq)
 	*lineLen = 0
 }
 
 func terminateOutput(lastSeenEscSeq string, out *strings.Builder) {
+	lastSeenEscSeq, hyperlink := splitEscSeq(lastSeenEscSeq)
 	if lastSeenEscSeq != "" && lastSeenEscSeq != EscapeReset && !strings.HasSuffix(out.String(), EscapeReset) {
 		out.WriteString(EscapeReset)
 	}
+	if hyperlink != "" {
+		out.WriteString(hyperlinkEnd)
+	}
 }
 
 func wrapHard(paragraph string, wrapLen int, out *strings.Builder) {
-	lineLen, lastSeenEscSeq := 0, ""
+	lineLen, lastSeenEscSeq, parser := 0, "", escSeqParser{}
 	words := strings.Fields(paragraph)
 	for wordIdx, word := range words {
-		escSeq := extractOpenEscapeSeq(word)
+		hyperlink := parser.hyperlink
+		escSeq := extractOpenEscapeSeq(word, &parser)
 		if escSeq != "" {
 			lastSeenEscSeq = escSeq
 		}
@@ -206,22 +260,23 @@ func wrapHard(paragraph string, wrapLen int, out *strings.Builder) {
 			out.WriteString(word)
 			lineLen += wordLen
 		} else { // word doesn't fit within the line; hard-wrap
-			appendWord(word, &lineLen, lastSeenEscSeq, wrapLen, out)
+			appendWord(word, &lineLen, lastSeenEscSeq+hyperlink, wrapLen, out)
 		}
 
 		// end of line; but more words incoming
 		if lineLen == wrapLen && wordIdx < len(words)-1 {
-			terminateLine(wrapLen, &lineLen, lastSeenEscSeq, out)
+			terminateLine(wrapLen, &lineLen, lastSeenEscSeq+parser.hyperlink, out)
 		}
 	}
-	terminateOutput(lastSeenEscSeq, out)
+	terminateOutput(lastSeenEscSeq+parser.hyperlink, out)
 }
 
-func wrapSoft(paragraph string, wrapLen int, out *strings.Builder) {
-	lineLen, lastSeenEscSeq := 0, ""
+func wrapSoft(paragraph string, wrapLen int, out *strings.Builder, appendLongWord wordAppender) {
+	lineLen, lastSeenEscSeq, parser := 0, "", escSeqParser{}
 	words := strings.Fields(paragraph)
 	for wordIdx, word := range words {
-		escSeq := extractOpenEscapeSeq(word)
+		hyperlink := parser.hyperlink
+		escSeq := extractOpenEscapeSeq(word, &parser)
 		if escSeq != "" {
 			lastSeenEscSeq = escSeq
 		}
@@ -237,20 +292,42 @@ func wrapSoft(paragraph string, wrapLen int, out *strings.Builder) {
 			lineLen += spacingLen + wordLen
 		} else { // word doesn't fit within the line
 			if lineLen > 0 { // something is already on the line; terminate it
-				terminateLine(wrapLen, &lineLen, lastSeenEscSeq, out)
+				terminateLine(wrapLen, &lineLen, lastSeenEscSeq+hyperlink, out)
 			}
 			if wordLen <= wrapLen { // word fits within a single line
 				out.WriteString(word)
 				lineLen = wordLen
 			} else { // word doesn't fit within a single line; hard-wrap
-				appendWord(word, &lineLen, lastSeenEscSeq, wrapLen, out)
+				appendLongWord(word, &lineLen, lastSeenEscSeq+hyperlink, wrapLen, out)
 			}
 		}
 
 		// end of line; but more words incoming
 		if lineLen == wrapLen && wordIdx < len(words)-1 {
-			terminateLine(wrapLen, &lineLen, lastSeenEscSeq, out)
+			terminateLine(wrapLen, &lineLen, lastSeenEscSeq+parser.hyperlink, out)
 		}
 	}
-	terminateOutput(lastSeenEscSeq, out)
+	terminateOutput(lastSeenEscSeq+parser.hyperlink, out)
+}
+
+func wrapSoftWith(str string, wrapLen int, appendLongWord wordAppender) string {
+	if wrapLen <= 0 {
+		return ""
+	}
+	str = strings.Replace(str, "\t", "    ", -1)
+	sLen := utf8.RuneCountInString(str)
+	if sLen <= wrapLen {
+		return str
+	}
+
+	out := &strings.Builder{}
+	out.Grow(sLen + (sLen / wrapLen))
+	for idx, paragraph := range strings.Split(str, "\n\n") {
+		if idx > 0 {
+			out.WriteString("\n\n")
+		}
+		wrapSoft(paragraph, wrapLen, out, appendLongWord)
+	}
+
+	return out.String()
 }
@@ -100,6 +100,65 @@ func TestWrapSoft(t *testing.T) {
 
 	assert.Equal(t, "\x1b[33mJon \x1b[0m\n\x1b[33mSnow\x1b[0m", WrapSoft("\x1b[33mJon Snow\x1b[0m", 4))
 	assert.Equal(t, "\x1b[33mJon \x1b[0m\n\x1b[33mSnow\x1b[0m\n\x1b[33m???\x1b[0m", WrapSoft("\x1b[33mJon Snow???\x1b[0m", 4))
+	assert.Equal(t,
+		"\x1b]8;;https://example.com/m\x1b\\Jon \x1b]8;;\x1b\\\n\x1b]8;;https://example.com/m\x1b\\Snow\x1b]8;;\x1b\\",
+		WrapSoft(Hyperlink("https://example.com/m", "Jon Snow"), 4))
+	assert.Equal(t,
+		"Jon \n\x1b]8;;https://example.com/m\x1b\\Snow\x1b]8;;\x1b\\",
+		WrapSoft("Jon "+Hyperlink("https://example.com/m", "Snow"), 4))
+}
+
+func ExampleWrapHyphenated() {
+	str := "Mary Poppins says Supercalifragilisticexpialidocious!"
+	strWrapped := WrapHyphenated(str, 12)
+	for idx, line := range strings.Split(strWrapped, "\n") {
+		fmt.Printf("Line #%02d: '%s'\n", idx+1, line)
+	}
+
+	// Output: Line #01: 'Mary Poppins'
+	// Line #02: 'says        '
+	// Line #03: 'Supercalifr-'
+	// Line #04: 'agilisticex-'
+	// Line #05: 'pialidociou-'
+	// Line #06: 's!'
+}
+
+func TestWrapHyphenated(t *testing.T) {
+	assert.Equal(t, "", WrapHyphenated("Ghost", 0))
+	assert.Equal(t, "G\nh\no\ns\nt", WrapHyphenated("Ghost", 1))
+	assert.Equal(t, "G-\nh-\no-\nst", WrapHyphenated("Ghost", 2))
+	assert.Equal(t, "Gh-\nost", WrapHyphenated("Ghost", 3))
+	assert.Equal(t, "Gho-\nst", WrapHyphenated("Ghost", 4))
+	assert.Equal(t, "Ghost", WrapHyphenated("Ghost", 5))
+	assert.Equal(t, "Ghost", WrapHyphenated("Ghost", 6))
+	assert.Equal(t, "Jon  \nis a \nSnow", WrapHyphenated("Jon is a Snow", 5))
+	assert.Equal(t, "Jon  \nSnow-\nball", WrapHyphenated("Jon Snowball", 5))
+	assert.Equal(t, "\x1b[33mGho-\x1b[0m\n\x1b[33mst\x1b[0m", WrapHyphenated("\x1b[33mGhost\x1b[0m", 4))
+	assert.Equal(t, "\x1b[33mJon \x1b[0m\n\x1b[33mSnow\x1b[0m", WrapHyphenated("\x1b[33mJon Snow\x1b[0m", 4))
+	assert.Equal(t, "\x1b[33mJon \x1b[0m\n\x1b[33mSno-\x1b[0m\n\x1b[33mwy\x1b[0m", WrapHyphenated("\x1b[33mJon Snowy\x1b[0m", 4))
+}
+
+func ExampleWrapSoftKeepURLs() {
+	str := "Refer to https://github.com/jedib0t/go-pretty for more details."
+	strWrapped := WrapSoftKeepURLs(str, 16)
+	for idx, line := range strings.Split(strWrapped, "\n") {
+		fmt.Printf("Line #%02d: '%s'\n", idx+1, line)
+	}
+
+	// Output: Line #01: 'Refer to        '
+	// Line #02: 'https://github.com/jedib0t/go-pretty'
+	// Line #03: 'for more        '
+	// Line #04: 'details.'
+}
+
+func TestWrapSoftKeepURLs(t *testing.T) {
+	assert.Equal(t, "", WrapSoftKeepURLs("Ghost", 0))
+	assert.Equal(t, "Gho\nst", WrapSoftKeepURLs("Ghost", 3))
+	assert.Equal(t, "Ghost", WrapSoftKeepURLs("Ghost", 5))
+	assert.Equal(t, "Jon  \nis a \nSnow", WrapSoftKeepURLs("Jon is a Snow", 5))
+	assert.Equal(t, "see  \nhttps://x.io/y\nnow", WrapSoftKeepURLs("see https://x.io/y now", 5))
+	assert.Equal(t, "see  \nwww.x.io/y\nnow", WrapSoftKeepURLs("see www.x.io/y now", 5))
+	assert.Equal(t, "\x1b[33mhttps://x.io/y\x1b[0m", WrapSoftKeepURLs("\x1b[33mhttps://x.io/y\x1b[0m", 5))
 }
 
 func ExampleWrapText() {
@@ -144,4 +203,13 @@ func TestWrapText(t *testing.T) {
 
 	complexIn := "+---+------+-------+------+\n| 1 | Arya | Stark | 3000 |\n+---+------+-------+------+"
 	assert.Equal(t, complexIn, WrapText(complexIn, 27))
+
+	hyperlink := Hyperlink("https://example.com/m", "Jon Snow")
+	assert.Equal(t, hyperlink, WrapText(hyperlink, 8))
+	assert.Equal(t,
+		"\x1b]8;;https://example.com/m\x1b\\Jon\x1b]8;;\x1b\\\n\x1b]8;;https://example.com/m\x1b\\ Sn\x1b]8;;\x1b\\\n\x1b]8;;https://example.com/m\x1b\\ow\x1b]8;;\x1b\\",
+		WrapText(hyperlink, 3))
+	assert.Equal(t,
+		"\x1b[33m\x1b]8;;https://example.com/m\x1b\\Jon\x1b[0m\x1b]8;;\x1b\\\n\x1b[33m\x1b]8;;https://example.com/m\x1b\\ Sn\x1b[0m\x1b]8;;\x1b\\\n\x1b[33m\x1b]8;;https://example.com/m\x1b\\ow\x1b]8;;\x1b\\\x1b[0m",
+		WrapText(FgYellow.Sprint(hyperlink), 3))
 }